@@ -1,7 +1,11 @@
 package actor
 
 import (
+	"errors"
+	"fmt"
+	"runtime/debug"
 	"sync"
+	"time"
 )
 
 // Actor represents a computational entity that manages the execution of a Worker
@@ -29,6 +33,63 @@ type Actor interface {
 	Stop()
 }
 
+// Waiter defines an optional interface implemented by Actors whose
+// termination can be observed without stopping them.
+type Waiter interface {
+	// Done returns a channel that is closed when the Actor has terminated,
+	// either because it was stopped or because it has ended on its own.
+	//
+	// If the Actor has not been started yet, the returned channel is closed
+	// once the upcoming run of the Actor terminates. After the Actor has
+	// terminated, Done returns a closed channel until the Actor is started again.
+	Done() <-chan struct{}
+
+	// Wait blocks the caller until the Actor has terminated.
+	//
+	// Calling Wait is equivalent to receiving from the Done channel.
+	Wait()
+}
+
+// Reasoner defines an optional interface implemented by Actors
+// that can report the reason of their termination.
+type Reasoner interface {
+	// Reason returns the reason why the Actor has terminated.
+	//
+	// The returned error is nil while the Actor is running or if it has never
+	// been started. Otherwise it is one of the following:
+	// 1. ErrStopped, when the Actor was stopped by calling Stop().
+	// 2. ErrWorkerEnded, when the Worker has returned a WorkerEnd status.
+	// 3. An error wrapping ErrWorkerFailed and the error returned by ErrWorker.
+	// 4. A *PanicError, when the Actor has recovered from a panic.
+	// 5. The error of the parent context supplied with OptContext,
+	//    when the parent context has ended.
+	Reason() error
+}
+
+var (
+	// ErrWorkerEnded is the termination reason of an Actor whose Worker
+	// has returned a WorkerEnd status.
+	ErrWorkerEnded = errors.New("worker ended")
+
+	// ErrWorkerFailed is wrapped by the termination reason of an Actor
+	// whose ErrWorker has returned an error.
+	ErrWorkerFailed = errors.New("worker failed")
+)
+
+// PanicError is the termination reason of an Actor that has recovered from
+// a panic raised by its Worker.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace of the goroutine that has panicked.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("worker panicked: %v", e.Value)
+}
+
 // WorkerStatus represents the status code returned by a Worker's DoWork function,
 // which indicates whether the Actor should continue executing the Worker or terminate it.
 type WorkerStatus int8
@@ -38,6 +99,28 @@ const (
 	WorkerEnd      WorkerStatus = 2
 )
 
+// RecoverPolicy defines how an Actor proceeds after it has recovered
+// from a panic raised by its Worker.
+type RecoverPolicy int8
+
+const (
+	// RecoverEnd ends the Actor, as if the Worker had returned WorkerEnd.
+	RecoverEnd RecoverPolicy = 1
+
+	// RecoverRestart restarts the worker loop, so DoWork is called again.
+	// Panics raised by OnStart and OnStop are handled as with RecoverEnd,
+	// because these functions are not part of the worker loop.
+	//
+	// When DoWork panics repeatedly, without returning in between, the worker
+	// loop is restarted with exponential backoff, starting at 10 milliseconds
+	// and capped at 1 second. Backoff is reset once DoWork returns.
+	RecoverRestart RecoverPolicy = 2
+
+	// RecoverPanic ends the Actor and panics again with the recovered value,
+	// after OnStop has been called.
+	RecoverPanic RecoverPolicy = 3
+)
+
 // Worker represents an entity that encapsulates the executable logic within an Actor.
 //
 // A Worker is responsible for processing incoming messages sent via Mailboxes
@@ -60,6 +143,23 @@ type Worker interface {
 // WorkerFunc defines the function signature for a Worker's DoWork method.
 type WorkerFunc = func(ctx Context) WorkerStatus
 
+// ErrWorker is a variant of Worker whose DoWork method can also report
+// an error.
+//
+// ErrWorker can be turned into a Worker with FromErrWorker. Like Worker,
+// it can optionally implement StartableWorker and StoppableWorker interfaces.
+type ErrWorker interface {
+	// DoWork defines a single unit of executable work for the ErrWorker.
+	//
+	// The method behaves the same as Worker.DoWork, except that returning
+	// a non-nil error ends the Actor regardless of the returned WorkerStatus.
+	// The error then becomes part of the Actor's termination reason.
+	DoWork(ctx Context) (WorkerStatus, error)
+}
+
+// ErrWorkerFunc defines the function signature for an ErrWorker's DoWork method.
+type ErrWorkerFunc = func(ctx Context) (WorkerStatus, error)
+
 // StartableWorker defines an optional interface that a Worker can implement
 // to perform initialization tasks before its main work begins.
 //
@@ -112,15 +212,72 @@ func (w *worker) DoWork(ctx Context) WorkerStatus {
 	return w.fn(ctx)
 }
 
+// NewErrWorker creates and returns a basic implementation of the ErrWorker
+// interface, which delegates its DoWork method to the provided ErrWorkerFunc.
+func NewErrWorker(fn ErrWorkerFunc) ErrWorker {
+	return &funcErrWorker{fn}
+}
+
+type funcErrWorker struct {
+	fn ErrWorkerFunc
+}
+
+func (w *funcErrWorker) DoWork(ctx Context) (WorkerStatus, error) {
+	return w.fn(ctx)
+}
+
+// FromErrWorker returns a Worker which executes the supplied ErrWorker.
+//
+// When the returned Worker is used by an Actor created with New, an error
+// returned by the ErrWorker ends the Actor and is reported as its termination
+// reason, see Reasoner.
+func FromErrWorker(w ErrWorker) Worker {
+	return &errWorker{worker: w}
+}
+
+type errWorker struct {
+	worker ErrWorker
+	err    error
+}
+
+func (w *errWorker) DoWork(ctx Context) WorkerStatus {
+	status, err := w.worker.DoWork(ctx)
+	if err != nil {
+		w.err = err
+		return WorkerEnd
+	}
+
+	return status
+}
+
+func (w *errWorker) OnStart(ctx Context) {
+	w.err = nil
+
+	if sw, ok := w.worker.(StartableWorker); ok {
+		sw.OnStart(ctx)
+	}
+}
+
+func (w *errWorker) OnStop() {
+	if sw, ok := w.worker.(StoppableWorker); ok {
+		sw.OnStop()
+	}
+}
+
 // New creates and returns a new Actor with the specified Worker and
 // optional configuration.
 //
 // Actors created with this method can be restarted, with the only limitations being
 // the logic defined within their OnStart(), OnStop(), and DoWork() functions.
 func New(w Worker, opt ...Option) Actor {
+	return newActor(w, newOptions(opt).Actor)
+}
+
+func newActor(w Worker, options optionsActor) *actor {
 	return &actor{
-		worker:  w,
-		options: newOptions(opt).Actor,
+		worker:        w,
+		options:       options,
+		workEndedSigC: make(chan struct{}),
 	}
 }
 
@@ -131,79 +288,288 @@ type actor struct {
 	workEndedSigC     chan struct{}
 	workerRunning     bool
 	workerRunningLock sync.Mutex
+	reason            error
+	panicErr          *PanicError
+	repanic           bool
+	state             stateTracker
 }
 
 func (a *actor) Stop() {
+	<-a.stop()
+}
+
+func (a *actor) StopContext(ctx Context) error {
+	return waitStopped(ctx, a.stop(), nil)
+}
+
+// stop ends context of this actor and returns channel
+// which is closed when worker has finished.
+func (a *actor) stop() <-chan struct{} {
 	a.workerRunningLock.Lock()
 	if !a.workerRunning {
 		a.workerRunningLock.Unlock()
-		return
+		return closedSigC
 	}
 
-	// End context, then wait for worker to finish
 	a.ctx.end()
+	a.state.compareAndSet(StateRunning, StateStopping)
 	workEndedSigC := a.workEndedSigC
 
 	a.workerRunningLock.Unlock()
 
-	<-workEndedSigC
+	a.state.notify()
+
+	return workEndedSigC
+}
+
+func (a *actor) onStopHook(fn func(reason error)) {
+	prevOnStopFunc := a.options.OnStopFunc
+
+	a.options.OnStopFunc = func(reason error) {
+		// deferred so that fn is called even if
+		// prevOnStopFunc panics and actor recovers from it.
+		defer fn(reason)
+
+		if prevOnStopFunc != nil {
+			prevOnStopFunc(reason)
+		}
+	}
 }
 
 func (a *actor) Start() {
+	a.start(nil)
+}
+
+// start starts the Actor, calling beforeStart, if supplied,
+// only when the Actor is not already running.
+func (a *actor) start(beforeStart func()) {
 	a.workerRunningLock.Lock()
-	defer a.workerRunningLock.Unlock()
 
 	if a.workerRunning {
+		a.workerRunningLock.Unlock()
 		return
 	}
 
-	a.workEndedSigC = make(chan struct{})
-	a.ctx = newContext()
+	if beforeStart != nil {
+		beforeStart()
+	}
+
+	// work ended channel is reused if actor has not been started before
+	if isClosed(a.workEndedSigC) {
+		a.workEndedSigC = make(chan struct{})
+	}
+
+	a.ctx = newContextWithParent(a.options.Context)
 	a.workerRunning = true
+	a.reason = nil
+	a.panicErr, a.repanic = nil, false
+	a.state.set(StateRunning)
 
 	go a.doWork()
+
+	a.workerRunningLock.Unlock()
+
+	a.state.notify()
 }
 
 // doWork executes Worker of this Actor until
 // Actor or Worker has signaled to stop.
 func (a *actor) doWork() {
-	a.onStart()
-
-	if ctx := a.ctx; ctx.Err() == nil {
-		for status := WorkerContinue; status == WorkerContinue; {
-			status = a.worker.DoWork(ctx)
-		}
+	if ctx := a.ctx; a.onStart() && ctx.Err() == nil {
+		a.runWorker(ctx)
 	}
 
+	reason := a.terminationReason()
+
 	// before calling onStop() ensure that context has ended
 	a.ctx.end()
-	a.onStop()
+
+	if a.state.compareAndSet(StateRunning, StateStopping) {
+		a.state.notify()
+	}
+
+	a.onStop(reason)
+
+	// read before worker has finished, because actor could be restarted after
+	repanic, panicErr := a.repanic, a.panicErr
 
 	{ // Worker has finished
 		a.workerRunningLock.Lock()
 		a.workerRunning = false
+		a.reason = reason
+		a.state.set(StateStopped)
 		close(a.workEndedSigC)
 		a.workerRunningLock.Unlock()
 	}
+
+	a.state.notify()
+
+	if repanic {
+		panic(panicErr.Value) //nolint:forbidigo // requested by RecoverPanic policy
+	}
+}
+
+func (a *actor) terminationReason() error {
+	if a.panicErr != nil {
+		return a.panicErr
+	}
+
+	if w, ok := a.worker.(*errWorker); ok && w.err != nil {
+		return fmt.Errorf("%w: %w", ErrWorkerFailed, w.err)
+	}
+
+	if err := a.ctx.Err(); err != nil {
+		return err
+	}
+
+	return ErrWorkerEnded
+}
+
+func (a *actor) Done() <-chan struct{} {
+	a.workerRunningLock.Lock()
+	defer a.workerRunningLock.Unlock()
+
+	return a.workEndedSigC
+}
+
+func (a *actor) Wait() {
+	<-a.Done()
+}
+
+func (a *actor) Reason() error {
+	a.workerRunningLock.Lock()
+	defer a.workerRunningLock.Unlock()
+
+	return a.reason
+}
+
+func (a *actor) State() State {
+	return a.state.get()
+}
+
+func (a *actor) SubscribeState(fn func(State)) func() {
+	return a.state.subscribe(fn)
+}
+
+const (
+	restartBackoffMin = 10 * time.Millisecond
+	restartBackoffMax = time.Second
+)
+
+func (a *actor) runWorker(ctx Context) {
+	var backoff time.Duration
+
+	for {
+		progressed := false
+		if a.protect(func() { a.workLoop(ctx, &progressed) }) {
+			return
+		}
+
+		if a.options.Recover != RecoverRestart || ctx.Err() != nil {
+			return
+		}
+
+		// panic recovered within worker loop is not termination reason
+		a.panicErr = nil
+
+		// backoff is reset once DoWork has returned without panic
+		if progressed {
+			backoff = 0
+		}
+
+		if !waitRestartBackoff(ctx, backoff) {
+			return
+		}
+
+		backoff = min(max(2*backoff, restartBackoffMin), restartBackoffMax)
+	}
+}
+
+func (a *actor) workLoop(ctx Context, progressed *bool) {
+	for status := WorkerContinue; status == WorkerContinue; {
+		status = a.worker.DoWork(ctx)
+		*progressed = true
+	}
+}
+
+// waitRestartBackoff waits for the backoff duration before worker loop is
+// restarted, returning false if ctx has ended in the meantime.
+func waitRestartBackoff(ctx Context, backoff time.Duration) bool {
+	if backoff == 0 {
+		return true
+	}
+
+	t := time.NewTimer(backoff)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
 }
 
-func (a *actor) onStart() {
+func (a *actor) onStart() bool {
 	if w, ok := a.worker.(StartableWorker); ok {
-		w.OnStart(a.ctx)
+		if !a.protect(func() { w.OnStart(a.ctx) }) {
+			return false
+		}
 	}
 
 	if fn := a.options.OnStartFunc; fn != nil {
-		fn(a.ctx)
+		return a.protect(func() { fn(a.ctx) })
 	}
+
+	return true
 }
 
-func (a *actor) onStop() {
+func (a *actor) onStop(reason error) {
 	if w, ok := a.worker.(StoppableWorker); ok {
-		w.OnStop()
+		a.protect(w.OnStop)
 	}
 
 	if fn := a.options.OnStopFunc; fn != nil {
+		a.protect(func() { fn(reason) })
+	}
+}
+
+// protect calls fn and, when OptRecover is used, recovers from panic
+// raised by fn. It returns false if fn has panicked.
+func (a *actor) protect(fn func()) bool {
+	if a.options.Recover == 0 {
 		fn()
+		return true
+	}
+
+	panicked := true
+
+	func() {
+		defer func() {
+			if panicked {
+				a.onPanic(recover())
+			}
+		}()
+
+		fn()
+
+		panicked = false
+	}()
+
+	return !panicked
+}
+
+func (a *actor) onPanic(value any) {
+	stack := debug.Stack()
+
+	if fn := a.options.OnPanicFunc; fn != nil {
+		fn(value, stack)
+	}
+
+	// first panic is termination reason
+	if a.panicErr == nil {
+		a.panicErr = &PanicError{Value: value, Stack: stack}
+		a.repanic = a.options.Recover == RecoverPanic
 	}
 }
 
@@ -214,6 +580,7 @@ func (a *actor) onStop() {
 func Idle(opt ...Option) Actor {
 	return &idleActor{
 		options: newOptions(opt),
+		doneC:   make(chan struct{}),
 	}
 }
 
@@ -222,9 +589,16 @@ type idleActor struct {
 	ctx              *context
 	lock             sync.Mutex
 	onStartFinishedC chan struct{}
+	reason           error
+	doneC            chan struct{}
+	doneLock         sync.Mutex
+	state            stateTracker
 }
 
 func (a *idleActor) Start() {
+	// subscribers are notified after lock is released
+	defer a.state.notify()
+
 	a.lock.Lock()
 	defer a.lock.Unlock()
 
@@ -235,6 +609,15 @@ func (a *idleActor) Start() {
 
 	a.ctx = newContext()
 	a.onStartFinishedC = make(chan struct{})
+	a.reason = nil
+
+	a.doneLock.Lock()
+	if isClosed(a.doneC) {
+		a.doneC = make(chan struct{})
+	}
+	a.doneLock.Unlock()
+
+	a.state.set(StateRunning)
 
 	if fn := a.options.Actor.OnStartFunc; fn != nil {
 		// run onStart in goroutine to keep the same
@@ -249,6 +632,9 @@ func (a *idleActor) Start() {
 }
 
 func (a *idleActor) Stop() {
+	// subscribers are notified after lock is released
+	defer a.state.notify()
+
 	a.lock.Lock()
 	defer a.lock.Unlock()
 
@@ -259,6 +645,9 @@ func (a *idleActor) Stop() {
 
 	a.ctx.end()
 	a.ctx = nil
+	a.reason = ErrStopped
+
+	a.state.set(StateStopping)
 
 	// wait for onStart func to finish before calling onStop
 	<-a.onStartFinishedC
@@ -266,8 +655,46 @@ func (a *idleActor) Stop() {
 	if fn := a.options.Actor.OnStopFunc; fn != nil {
 		// since Stop() needs to wait for onStop to finish execution
 		// there is no need to execute it in separate goroutine.
-		fn()
+		fn(ErrStopped)
 	}
+
+	a.state.set(StateStopped)
+
+	a.doneLock.Lock()
+	close(a.doneC)
+	a.doneLock.Unlock()
+}
+
+func (a *idleActor) StopContext(ctx Context) error {
+	return stopWithContext(ctx, a.Stop)
+}
+
+func (a *idleActor) Done() <-chan struct{} {
+	// separate lock is used so that Done does not block while
+	// Stop is waiting for OnStart and OnStop functions.
+	a.doneLock.Lock()
+	defer a.doneLock.Unlock()
+
+	return a.doneC
+}
+
+func (a *idleActor) Wait() {
+	<-a.Done()
+}
+
+func (a *idleActor) Reason() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.reason
+}
+
+func (a *idleActor) State() State {
+	return a.state.get()
+}
+
+func (a *idleActor) SubscribeState(fn func(State)) func() {
+	return a.state.subscribe(fn)
 }
 
 // Noop returns no-op Actor.
@@ -288,3 +715,22 @@ type noopActor struct{}
 
 func (a *noopActor) Start() {}
 func (a *noopActor) Stop()  {}
+
+//nolint:gochecknoglobals // this is singleton value
+var closedSigC = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+
+	return c
+}()
+
+// isClosed reports whether channel c is closed, assuming that
+// values are never sent to it.
+func isClosed(c chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
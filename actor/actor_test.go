@@ -1,6 +1,11 @@
 package actor_test
 
 import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,6 +37,133 @@ func Test_NewWorker(t *testing.T) {
 	}
 }
 
+// Test asserts that ErrWorker created with NewErrWorker utility
+// will delegate call to supplied ErrWorkerFunc.
+func Test_NewErrWorker(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("🔥")
+	ctx := ContextStarted()
+	w := NewErrWorker(func(c Context) (WorkerStatus, error) {
+		assert.Equal(t, ctx, c)
+		return WorkerContinue, errTest
+	})
+	assert.NotNil(t, w)
+
+	status, err := w.DoWork(ctx)
+	assert.Equal(t, WorkerContinue, status)
+	assert.ErrorIs(t, err, errTest)
+}
+
+// Test asserts that Worker created with FromErrWorker ends when ErrWorker
+// returns error and that it delegates OnStart and OnStop calls.
+func Test_FromErrWorker(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("🔥")
+	ctx := ContextStarted()
+
+	w := FromErrWorker(NewErrWorker(func(Context) (WorkerStatus, error) {
+		return WorkerContinue, errTest
+	}))
+	assert.Equal(t, WorkerEnd, w.DoWork(ctx))
+
+	w = FromErrWorker(NewErrWorker(func(Context) (WorkerStatus, error) {
+		return WorkerContinue, nil
+	}))
+	assert.Equal(t, WorkerContinue, w.DoWork(ctx))
+	w.(StartableWorker).OnStart(ctx) //nolint:forcetypeassert // relax
+	w.(StoppableWorker).OnStop()     //nolint:forcetypeassert // relax
+
+	ew := &errWorker{worker: newWorker()}
+	w = FromErrWorker(ew)
+
+	w.(StartableWorker).OnStart(ctx) //nolint:forcetypeassert // relax
+	assert.Equal(t, `🌞`, <-ew.onStartC)
+	w.(StoppableWorker).OnStop() //nolint:forcetypeassert // relax
+	assert.Equal(t, `🌚`, <-ew.onStopC)
+
+	AssertWorkerEndSig(t, w)
+}
+
+// Test asserts that Actor reports correct termination reason.
+//
+//nolint:maintidx // long test case
+func Test_Actor_Reason(t *testing.T) {
+	t.Parallel()
+
+	{ // Reason should be nil until actor is stopped
+		a := New(newWorker())
+		assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+		a.Start()
+		assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+		a.Stop()
+		assert.ErrorIs(t, a.(Reasoner).Reason(), ErrStopped) //nolint:forcetypeassert // relax
+
+		// Restart should reset reason
+		a.Start()
+		assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+		a.Stop()
+	}
+
+	assertReason := func(w Worker, opts ...Option) error {
+		reasonC := make(chan error, 1)
+		opts = append(opts, OptOnStopWithReason(func(r error) { reasonC <- r }))
+		a := New(w, opts...)
+
+		a.Start()
+		reason := <-reasonC
+		a.Stop() // ensures that actor has ended
+
+		assert.Equal(t, reason, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+		return reason
+	}
+
+	{ // Worker ended
+		reason := assertReason(NewWorker(func(Context) WorkerStatus {
+			return WorkerEnd
+		}))
+		assert.ErrorIs(t, reason, ErrWorkerEnded)
+	}
+
+	{ // ErrWorker returned error
+		errTest := errors.New("🔥")
+		reason := assertReason(FromErrWorker(NewErrWorker(
+			func(Context) (WorkerStatus, error) { return WorkerContinue, errTest },
+		)))
+		assert.ErrorIs(t, reason, ErrWorkerFailed)
+		assert.ErrorIs(t, reason, errTest)
+	}
+
+	{ // Worker panicked
+		reason := assertReason(NewWorker(func(Context) WorkerStatus {
+			panic(`🔥`) //nolint:forbidigo // used in test
+		}), OptRecover(RecoverEnd, nil))
+
+		var panicErr *PanicError
+
+		assert.ErrorAs(t, reason, &panicErr)
+		assert.Equal(t, `🔥`, panicErr.Value)
+		assert.NotEmpty(t, panicErr.Stack)
+		assert.Contains(t, panicErr.Error(), `🔥`)
+	}
+
+	{ // Panic recovered within worker loop should not be reason
+		count := 0
+		reason := assertReason(NewWorker(func(Context) WorkerStatus {
+			if count++; count == 1 {
+				panic(`🔥`) //nolint:forbidigo // used in test
+			}
+
+			return WorkerEnd
+		}), OptRecover(RecoverRestart, nil))
+		assert.ErrorIs(t, reason, ErrWorkerEnded)
+	}
+}
+
 // Test asserts that Actor will execute underlying worker.
 func Test_Actor_New(t *testing.T) {
 	t.Parallel()
@@ -369,6 +501,268 @@ func Test_Actor_ContextEndedAfterStop(t *testing.T) {
 	assertContextEnded(t, w.ctx)
 }
 
+// Test asserts that actor with RecoverEnd policy recovers from panic in DoWork,
+// reports it and ends, while still calling OnStop callbacks.
+func Test_Actor_OptRecover_End(t *testing.T) {
+	t.Parallel()
+
+	panicC := make(chan any, 1)
+	onStopC, onStopFn := createOnStopOption(t, 1)
+	w := &panicWorker{panicC: make(chan any, 1), onStopC: make(chan any, 1)}
+	a := New(w,
+		OptRecover(RecoverEnd, func(value any, stack []byte) {
+			assert.NotEmpty(t, stack)
+			panicC <- value
+		}),
+		OptOnStop(onStopFn),
+	)
+
+	for range 3 { // actor should be restartable after recovered panic
+		a.Start()
+
+		w.panicC <- `🔥`
+		assert.Equal(t, `🔥`, <-panicC)
+		assert.Equal(t, `🌚`, <-w.onStopC)
+		assert.Equal(t, `🌚`, <-onStopC)
+
+		a.Stop()
+	}
+}
+
+// Test asserts that actor with RecoverRestart policy keeps running
+// worker after recovering from panic in DoWork.
+func Test_Actor_OptRecover_Restart(t *testing.T) {
+	t.Parallel()
+
+	panicC := make(chan any, 1)
+	w := &panicWorker{panicC: make(chan any, 1), onStopC: make(chan any, 1)}
+	a := New(w,
+		OptRecover(RecoverRestart, func(value any, _ []byte) { panicC <- value }),
+	)
+
+	a.Start()
+
+	for range 3 {
+		w.panicC <- `🔥`
+		assert.Equal(t, `🔥`, <-panicC)
+		assert.Empty(t, w.onStopC)
+	}
+
+	a.Stop()
+	assert.Equal(t, `🌚`, <-w.onStopC)
+
+	// Panic should end actor if context has ended in the meantime
+	a = New(NewWorker(func(ctx Context) WorkerStatus {
+		<-ctx.Done()
+		panic(`🔥`) //nolint:forbidigo // used in test
+	}), OptRecover(RecoverRestart, nil))
+
+	a.Start()
+	a.Stop()
+}
+
+// Test asserts that actor with RecoverRestart policy restarts worker loop
+// with backoff when DoWork panics repeatedly.
+func Test_Actor_OptRecover_RestartBackoff(t *testing.T) {
+	t.Parallel()
+
+	panics := atomic.Int64{}
+	a := New(NewWorker(func(Context) WorkerStatus {
+		panic(`🔥`) //nolint:forbidigo // used in test
+	}), OptRecover(RecoverRestart, func(any, []byte) { panics.Add(1) }))
+
+	a.Start()
+	time.Sleep(100 * time.Millisecond) //nolint:forbidigo // relax
+	a.Stop()
+
+	// restarts are delayed by 0, 10, 20, 40 and 80 milliseconds
+	assert.Less(t, panics.Load(), int64(10))
+	assert.Positive(t, panics.Load())
+}
+
+// Test asserts that panics in OnStart and OnStop are recovered, ending actor
+// while still calling remaining OnStop callbacks.
+func Test_Actor_OptRecover_OnStartOnStop(t *testing.T) {
+	t.Parallel()
+
+	panicC := make(chan any, 2)
+	onPanic := func(value any, _ []byte) { panicC <- value }
+
+	{ // panic in OnStart should end actor without calling DoWork
+		onStopC, onStopFn := createOnStopOption(t, 1)
+		workerCalled := false
+		a := New(
+			NewWorker(func(Context) WorkerStatus {
+				workerCalled = true
+				return WorkerEnd
+			}),
+			OptRecover(RecoverRestart, onPanic),
+			OptOnStart(func(Context) { panic(`🌞`) }), //nolint:forbidigo // used in test
+			OptOnStop(onStopFn),
+		)
+
+		a.Start()
+		assert.Equal(t, `🌞`, <-panicC)
+		assert.Equal(t, `🌚`, <-onStopC)
+		a.Stop()
+
+		assert.False(t, workerCalled)
+	}
+
+	{ // panic in worker's OnStart should skip OptOnStart
+		onStartC, onStartFn := createOnStartOption(t, 1)
+		w := &panicWorker{onStartPanic: true, onStopC: make(chan any, 1)}
+		a := New(w, OptRecover(RecoverEnd, onPanic), OptOnStart(onStartFn))
+
+		a.Start()
+		assert.Equal(t, `🌞`, <-panicC)
+		assert.Equal(t, `🌚`, <-w.onStopC)
+		a.Stop()
+
+		assert.Empty(t, onStartC)
+	}
+
+	{ // panic in worker's OnStop should not skip OptOnStop
+		onStopC, onStopFn := createOnStopOption(t, 1)
+		w := &panicWorker{onStopPanic: true, onStopC: make(chan any, 1)}
+		a := New(w, OptRecover(RecoverEnd, onPanic), OptOnStop(onStopFn))
+
+		a.Start()
+		a.Stop()
+		assert.Equal(t, `🌚`, <-panicC)
+		assert.Equal(t, `🌚`, <-onStopC)
+	}
+}
+
+// Test asserts that actor with RecoverPanic policy panics again after calling
+// OnStop. Since this panic terminates program, test is run in a subprocess.
+func Test_Actor_OptRecover_Panic(t *testing.T) {
+	t.Parallel()
+
+	const envKey = "GO_ACTOR_TEST_REPANIC"
+
+	if os.Getenv(envKey) != "" {
+		w := &panicWorker{panicC: make(chan any, 1), onStopC: make(chan any, 1)}
+		a := New(w, OptRecover(RecoverPanic, nil), OptOnStop(func() {
+			os.Stderr.WriteString("onStop called\n") //nolint:errcheck // relax
+		}))
+
+		a.Start()
+		w.panicC <- `🔥`
+
+		<-time.After(time.Second)
+
+		return
+	}
+
+	//nolint:gosec // running this test binary
+	cmd := exec.Command(os.Args[0], "-test.run=^Test_Actor_OptRecover_Panic$")
+	cmd.Env = append(os.Environ(), envKey+"=1")
+	out, err := cmd.CombinedOutput()
+
+	assert.Error(t, err)
+	assert.Contains(t, string(out), "onStop called")
+	assert.Contains(t, string(out), "panic: "+`🔥`)
+}
+
+// Test asserts that actor created with OptContext inherits values from
+// parent context and that it ends when parent context ends.
+func Test_Actor_OptContext(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey struct{}
+
+	parent, cancel := context.WithCancel(context.WithValue(
+		context.Background(), ctxKey{}, `🔑`,
+	))
+	valueC := make(chan any, 1)
+	a := New(NewWorker(func(ctx Context) WorkerStatus {
+		valueC <- ctx.Value(ctxKey{})
+		<-ctx.Done()
+
+		return WorkerEnd
+	}), OptContext(parent))
+
+	w := a.(Waiter)   //nolint:forcetypeassert // relax
+	r := a.(Reasoner) //nolint:forcetypeassert // relax
+
+	// Stopping actor should not affect parent context
+	a.Start()
+	assert.Equal(t, `🔑`, <-valueC)
+	a.Stop()
+	assert.ErrorIs(t, r.Reason(), ErrStopped)
+	assert.NoError(t, parent.Err())
+
+	// Ending parent context should end actor
+	a.Start()
+	assert.Equal(t, `🔑`, <-valueC)
+	cancel()
+	w.Wait()
+	assert.ErrorIs(t, r.Reason(), context.Canceled)
+
+	// Actor started with ended parent context should not execute worker
+	a.Start()
+	w.Wait()
+	assert.ErrorIs(t, r.Reason(), context.Canceled)
+	assert.Empty(t, valueC)
+}
+
+// Test asserts that Done channel of actor is closed when actor terminates.
+func Test_Actor_Waiter(t *testing.T) {
+	t.Parallel()
+
+	endC := make(chan any)
+	a := New(NewWorker(func(ctx Context) WorkerStatus {
+		select {
+		case <-ctx.Done():
+		case <-endC:
+		}
+
+		return WorkerEnd
+	}))
+	w := a.(Waiter) //nolint:forcetypeassert // relax
+
+	// Done channel before start should be closed when first run ends
+	doneC := w.Done()
+	assertNotDone(t, doneC)
+
+	a.Start()
+	assert.Equal(t, doneC, w.Done())
+	assertNotDone(t, doneC)
+
+	endC <- `🛑` // worker ends on its own
+	w.Wait()
+	assertDone(t, doneC)
+	assertDone(t, w.Done())
+
+	// Restarting actor should create new Done channel
+	a.Start()
+	doneC = w.Done()
+	assertNotDone(t, doneC)
+
+	a.Stop()
+	assertDone(t, doneC)
+}
+
+// Test asserts that Done channel of idle actor is closed when actor is stopped.
+func Test_Idle_Waiter(t *testing.T) {
+	t.Parallel()
+
+	a := Idle()
+	w := a.(Waiter) //nolint:forcetypeassert // relax
+
+	for range 3 {
+		a.Start()
+		doneC := w.Done()
+		assertNotDone(t, doneC)
+
+		a.Stop()
+		w.Wait()
+		assertDone(t, doneC)
+		assertDone(t, w.Done())
+	}
+}
+
 // This test could not assert much, except that test
 // should not panic when Start() and Stop() are called.
 func Test_Noop(t *testing.T) {
@@ -385,6 +779,22 @@ func Test_Idle(t *testing.T) {
 	AssertStartStopAtRandom(t, Idle())
 }
 
+// Test asserts that Idle actor reports correct termination reason.
+func Test_Idle_Reason(t *testing.T) {
+	t.Parallel()
+
+	reasonC := make(chan error, 1)
+	a := Idle(OptOnStopWithReason(func(r error) { reasonC <- r }))
+	assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+	a.Start()
+	assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+	a.Stop()
+	assert.ErrorIs(t, <-reasonC, ErrStopped)
+	assert.ErrorIs(t, a.(Reasoner).Reason(), ErrStopped) //nolint:forcetypeassert // relax
+}
+
 // Test asserts that OnStart and OnStop callbacks
 // are being called.
 func Test_Idle_Options(t *testing.T) {
@@ -411,6 +821,26 @@ func Test_Idle_Options(t *testing.T) {
 	assert.Empty(t, onStopC)
 }
 
+func assertDone(t *testing.T, doneC <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		assert.FailNow(t, "done channel should be closed")
+	}
+}
+
+func assertNotDone(t *testing.T, doneC <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-doneC:
+		assert.FailNow(t, "done channel should not be closed")
+	default:
+	}
+}
+
 func newWorker() *worker {
 	return &worker{
 		doWorkC:  make(chan chan int, 1),
@@ -467,6 +897,44 @@ func (w *worker) OnStop() {
 	}
 }
 
+type errWorker struct {
+	*worker
+}
+
+func (w *errWorker) DoWork(ctx Context) (WorkerStatus, error) {
+	return w.worker.DoWork(ctx), nil
+}
+
+type panicWorker struct {
+	panicC       chan any
+	onStopC      chan any
+	onStartPanic bool
+	onStopPanic  bool
+}
+
+func (w *panicWorker) DoWork(ctx Context) WorkerStatus {
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+	case v := <-w.panicC:
+		panic(v) //nolint:forbidigo // used in test
+	}
+}
+
+func (w *panicWorker) OnStart(Context) {
+	if w.onStartPanic {
+		panic(`🌞`) //nolint:forbidigo // used in test
+	}
+}
+
+func (w *panicWorker) OnStop() {
+	w.onStopC <- `🌚`
+
+	if w.onStopPanic {
+		panic(`🌚`) //nolint:forbidigo // used in test
+	}
+}
+
 const workIterationsPerAssert = 20
 
 func assertDoWorkWithStart(t *testing.T, doWorkC chan chan int, start int) {
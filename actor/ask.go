@@ -0,0 +1,75 @@
+package actor
+
+import "fmt"
+
+// Envelope pairs a request with a one-shot reply Mailbox, which is used
+// by the receiver of the request to reply to the sender.
+//
+// Envelopes are created by Ask and should be replied to at most once.
+type Envelope[Req, Resp any] struct {
+	// Request is the request sent with Ask.
+	Request Req
+
+	reply Mailbox[Resp]
+}
+
+// Reply sends resp to the sender of the request.
+//
+// ErrMailboxStopped is returned if the sender is no longer waiting for
+// the reply, for example because the Context supplied to Ask has ended.
+func (e Envelope[Req, Resp]) Reply(ctx Context, resp Resp) error {
+	if e.reply == nil {
+		return fmt.Errorf("Envelope.Reply failed: %w", ErrMailboxStopped)
+	}
+
+	return e.reply.Send(ctx, resp)
+}
+
+// Ask sends req to the supplied Mailbox, wrapped in an Envelope, and blocks
+// the caller until the receiver replies to it.
+//
+// The supplied ctx bounds both sending the request and waiting for the reply,
+// in which case the error of ctx is returned. ErrMailboxStopped is returned
+// when the Mailbox is stopped before the request is sent or, for Mailboxes
+// implementing the Waiter interface, before the reply is received.
+//
+// Ask works with any MailboxSender, including Mailboxes created with OptAsChan.
+func Ask[Req, Resp any](
+	ctx Context,
+	mbx MailboxSender[Envelope[Req, Resp]],
+	req Req,
+) (Resp, error) {
+	var zero Resp
+
+	reply := NewMailbox[Resp](OptAsChan(), OptCapacity(1))
+	reply.Start()
+
+	// stopping reply mailbox ensures that late replies fail with ErrMailboxStopped
+	defer reply.Stop()
+
+	if err := mbx.Send(ctx, Envelope[Req, Resp]{Request: req, reply: reply}); err != nil {
+		return zero, err
+	}
+
+	var mbxDoneC <-chan struct{}
+	if w, ok := mbx.(Waiter); ok {
+		mbxDoneC = w.Done()
+	}
+
+	select {
+	case resp := <-reply.ReceiveC():
+		return resp, nil
+
+	case <-ctx.Done():
+		return zero, fmt.Errorf("Ask canceled: %w", ctx.Err())
+
+	case <-mbxDoneC:
+		// receiver could have replied before mailbox has stopped
+		select {
+		case resp := <-reply.ReceiveC():
+			return resp, nil
+		default:
+			return zero, fmt.Errorf("Ask canceled: %w", ErrMailboxStopped)
+		}
+	}
+}
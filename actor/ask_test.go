@@ -0,0 +1,117 @@
+package actor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that Ask returns reply of the receiver,
+// for both kinds of mailboxes.
+func Test_Ask(t *testing.T) {
+	t.Parallel()
+
+	for _, mbx := range []Mailbox[Envelope[int, int]]{
+		NewMailbox[Envelope[int, int]](),
+		NewMailbox[Envelope[int, int]](OptAsChan()),
+	} {
+		a := Combine(mbx, New(newDoublerWorker(mbx))).Build()
+		a.Start()
+
+		for i := range 100 {
+			resp, err := Ask[int, int](ContextStarted(), mbx, i)
+			assert.NoError(t, err)
+			assert.Equal(t, i*2, resp)
+		}
+
+		a.Stop()
+
+		// Asking stopped mailbox should return error
+		resp, err := Ask[int, int](ContextStarted(), mbx, 1)
+		assert.ErrorIs(t, err, ErrMailboxStopped)
+		assert.Zero(t, resp)
+	}
+}
+
+// Test asserts that Ask returns error when context ends
+// before receiver replies.
+func Test_Ask_ContextEnded(t *testing.T) {
+	t.Parallel()
+
+	mbx := NewMailbox[Envelope[int, int]]()
+	mbx.Start()
+	defer mbx.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+
+	resp, err := Ask[int, int](ctx, mbx, 1)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Zero(t, resp)
+
+	// Replying after Ask has returned should fail
+	envelope := <-mbx.ReceiveC()
+	assert.Equal(t, 1, envelope.Request)
+	assert.ErrorIs(t, envelope.Reply(ContextStarted(), 2), ErrMailboxStopped)
+
+	// Sending request with ended context should fail
+	resp, err = Ask[int, int](ContextEnded(), NewMailbox[Envelope[int, int]](), 1)
+	assert.ErrorIs(t, err, ErrStopped)
+	assert.Zero(t, resp)
+}
+
+// Test asserts that Ask returns error when mailbox is stopped
+// after request was sent and before receiver replied.
+func Test_Ask_MailboxStopped(t *testing.T) {
+	t.Parallel()
+
+	for _, mbx := range []Mailbox[Envelope[int, int]]{
+		NewMailbox[Envelope[int, int]](),
+		NewMailbox[Envelope[int, int]](OptAsChan(), OptCapacity(1)),
+	} {
+		mbx.Start()
+
+		errC := make(chan error, 1)
+		go func() {
+			_, err := Ask[int, int](ContextStarted(), mbx, 1)
+			errC <- err
+		}()
+
+		// Wait for request to be sent, then stop mailbox without replying
+		envelope := <-mbx.ReceiveC()
+		mbx.Stop()
+
+		assert.ErrorIs(t, <-errC, ErrMailboxStopped)
+		assert.ErrorIs(t, envelope.Reply(ContextStarted(), 2), ErrMailboxStopped)
+	}
+}
+
+// Test asserts that replying to zero value Envelope returns error.
+func Test_Envelope_ZeroValue(t *testing.T) {
+	t.Parallel()
+
+	envelope := Envelope[int, int]{Request: 1}
+	assert.ErrorIs(t, envelope.Reply(ContextStarted(), 2), ErrMailboxStopped)
+}
+
+func newDoublerWorker(mbx MailboxReceiver[Envelope[int, int]]) Worker {
+	return NewWorker(func(ctx Context) WorkerStatus {
+		select {
+		case <-ctx.Done():
+			return WorkerEnd
+
+		case envelope, ok := <-mbx.ReceiveC():
+			if !ok {
+				return WorkerEnd
+			}
+
+			envelope.Reply(ctx, envelope.Request*2) //nolint:errcheck // relax
+
+			return WorkerContinue
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package actor
+
+import "time"
+
+// Clock provides the current time and timers to Actors which depend on time.
+//
+// Actors use the system clock by default. Another Clock can be supplied
+// with OptClock, which allows time to be controlled in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer creates a new Timer which fires once d has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event timer created by Clock.
+type Timer interface {
+	// C returns the channel on which the time is delivered when Timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents Timer from firing. It returns false if Timer
+	// has already fired or has been stopped.
+	Stop() bool
+
+	// Reset changes Timer to fire once d has elapsed. It returns true
+	// if Timer had been active, false if Timer had fired or been stopped.
+	Reset(d time.Duration) bool
+}
+
+// SystemClock returns Clock backed by the time package.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// resetTimer stops t, discarding time which it could have delivered,
+// and resets it to fire once d has elapsed.
+func resetTimer(t Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C():
+		default:
+		}
+	}
+
+	t.Reset(d)
+}
@@ -0,0 +1,127 @@
+package actor_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+func Test_SystemClock(t *testing.T) {
+	t.Parallel()
+
+	clock := SystemClock()
+
+	before := time.Now()
+	now := clock.Now()
+	assert.False(t, now.Before(before))
+
+	timer := clock.NewTimer(time.Millisecond)
+	firedAt := <-timer.C()
+	assert.False(t, firedAt.Before(now))
+	assert.False(t, timer.Stop())
+
+	assert.False(t, timer.Reset(time.Hour))
+	assert.True(t, timer.Stop())
+}
+
+// fakeClock is Clock whose time is advanced manually.
+//
+// Whenever fakeClock is advanced, all of its active timers are fired,
+// regardless of their duration, so that timers which were created
+// concurrently with advancing are not missed. Durations of timers
+// are recorded instead, so that they can be asserted.
+type fakeClock struct {
+	lock      sync.Mutex
+	now       time.Time
+	timers    []*fakeTimer
+	durations []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.durations = append(c.durations, d)
+
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1), active: true}
+	c.timers = append(c.timers, t)
+
+	return t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(d)
+
+	for _, t := range c.timers {
+		if t.active {
+			t.active = false
+
+			select {
+			case t.c <- c.now:
+			default:
+			}
+		}
+	}
+}
+
+// LastDuration returns duration of the last created or reset timer.
+func (c *fakeClock) LastDuration() time.Duration {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.durations) == 0 {
+		return 0
+	}
+
+	return c.durations[len(c.durations)-1]
+}
+
+type fakeTimer struct {
+	clock  *fakeClock
+	c      chan time.Time
+	active bool
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.lock.Lock()
+	defer t.clock.lock.Unlock()
+
+	wasActive := t.active
+	t.active = false
+
+	return wasActive
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.lock.Lock()
+	defer t.clock.lock.Unlock()
+
+	t.clock.durations = append(t.clock.durations, d)
+
+	wasActive := t.active
+	t.active = true
+
+	return wasActive
+}
@@ -35,10 +35,17 @@ func (b *CombineBuilder) Build() Actor {
 		return Idle(options...)
 	}
 
+	return newCombinedActor(b.actors, b.options.Combined)
+}
+
+// newCombinedActor returns combined Actor of the specified actors, which
+// must not be empty. It is used by Actors which embed combined Actor.
+func newCombinedActor(actors []Actor, options optionsCombined) *combinedActor {
 	a := &combinedActor{
-		actors:   b.actors,
-		options:  b.options.Combined,
+		actors:   actors,
+		options:  options,
 		stopping: &atomic.Bool{},
+		doneC:    make(chan struct{}),
 	}
 
 	a.actors = wrapActors(a.actors, a.onActorStopped)
@@ -66,22 +73,46 @@ type combinedActor struct {
 	running      bool
 	runningLock  sync.Mutex
 	stopping     *atomic.Bool
+	reason       error
+	doneC        chan struct{}
+	state        stateTracker
 }
 
-func (a *combinedActor) onActorStopped() {
+func (a *combinedActor) onActorStopped(reason error) {
 	a.runningLock.Lock()
 
+	// First actor to end on its own determines termination reason
+	if a.reason == nil && !a.stopping.Load() {
+		a.reason = reason
+	}
+
+	reason = a.reason
+
 	a.runningCount--
-	noRunningActors := a.runningCount == 0
 
-	wasRunning := a.running
-	a.running = a.runningCount != 0
+	// Combined actor is running until onStopFunc of
+	// the last actor to end has returned
+	ended := a.runningCount == 0 && a.running
+	if !ended {
+		a.running = a.runningCount != 0
+	}
 
 	a.runningLock.Unlock()
 
-	// Last actor to end should call onStopFunc
-	if noRunningActors && wasRunning && a.options.OnStopFunc != nil {
-		a.options.OnStopFunc()
+	a.state.notify()
+
+	if ended {
+		if fn := a.options.OnStopFunc; fn != nil {
+			fn(reason)
+		}
+
+		a.runningLock.Lock()
+		a.running = false
+		a.state.set(StateStopped)
+		close(a.doneC)
+		a.runningLock.Unlock()
+
+		a.state.notify()
 	}
 
 	// First actor to stop should stop other actors
@@ -98,22 +129,48 @@ func (a *combinedActor) onActorStopped() {
 }
 
 func (a *combinedActor) Stop() {
+	if !a.beginStop() {
+		return
+	}
+
+	if a.options.StopParallel {
+		stopAllParallel(a.actors)
+	} else {
+		stopAll(a.actors)
+	}
+}
+
+func (a *combinedActor) StopContext(ctx Context) error {
+	if !a.beginStop() {
+		return nil
+	}
+
+	return stopAllContext(ctx, a.actors, a.options.StopParallel)
+}
+
+// beginStop marks this actor as stopping and reports
+// whether combined actors should be stopped.
+func (a *combinedActor) beginStop() bool {
 	a.runningLock.Lock()
 
-	if !a.running || a.stopping.Swap(true) {
+	// actors are not stopped again after all of them have ended
+	if !a.running || a.runningCount == 0 || a.stopping.Swap(true) {
 		a.runningLock.Unlock()
-		return
+		return false
+	}
+
+	if a.reason == nil {
+		a.reason = ErrStopped
 	}
 
 	a.ctx.end()
+	a.state.set(StateStopping)
 
 	a.runningLock.Unlock()
 
-	if a.options.StopParallel {
-		stopAllParallel(a.actors)
-	} else {
-		stopAll(a.actors)
-	}
+	a.state.notify()
+
+	return true
 }
 
 func (a *combinedActor) Start() {
@@ -128,7 +185,14 @@ func (a *combinedActor) Start() {
 	a.ctx = ctx
 	a.stopping.Store(false)
 	a.running = true
+	a.reason = nil
+
+	if isClosed(a.doneC) {
+		a.doneC = make(chan struct{})
+	}
+
 	a.runningCount += len(a.actors)
+	a.state.set(StateRunning)
 
 	a.runningLock.Unlock()
 
@@ -137,6 +201,40 @@ func (a *combinedActor) Start() {
 	}
 
 	startAll(a.actors)
+
+	// subscribers are notified after all actors have started,
+	// so that they are able to stop combined actor
+	a.state.notify()
+}
+
+func (a *combinedActor) Done() <-chan struct{} {
+	a.runningLock.Lock()
+	defer a.runningLock.Unlock()
+
+	return a.doneC
+}
+
+func (a *combinedActor) Wait() {
+	<-a.Done()
+}
+
+func (a *combinedActor) Reason() error {
+	a.runningLock.Lock()
+	defer a.runningLock.Unlock()
+
+	if a.running {
+		return nil
+	}
+
+	return a.reason
+}
+
+func (a *combinedActor) State() State {
+	return a.state.get()
+}
+
+func (a *combinedActor) SubscribeState(fn func(State)) func() {
+	return a.state.subscribe(fn)
 }
 
 func startAll(actors []Actor) {
@@ -164,62 +262,47 @@ func stopAllParallel(actors []Actor) {
 	}
 }
 
-func wrapActors(
-	actors []Actor,
-	onStopFunc func(),
-) []Actor {
-	wrapActorStruct := func(a *actor) *actor {
-		prevOnStopFunc := a.options.OnStopFunc
+// stopHooker is implemented by Actors which are able to signal their own
+// termination, and therefore by all types which embed them.
+type stopHooker interface {
+	// onStopHook adds fn, which is called whenever the Actor has stopped.
+	onStopHook(fn func(reason error))
+}
 
-		a.options.OnStopFunc = func() {
-			if prevOnStopFunc != nil {
-				prevOnStopFunc()
-			}
+func (a *combinedActor) onStopHook(fn func(reason error)) {
+	prevOnStopFunc := a.options.OnStopFunc
 
-			onStopFunc()
+	a.options.OnStopFunc = func(reason error) {
+		if prevOnStopFunc != nil {
+			prevOnStopFunc(reason)
 		}
 
-		return a
+		fn(reason)
 	}
+}
 
-	wrapCombinedActorStruct := func(a *combinedActor) *combinedActor {
-		prevOnStopFunc := a.options.OnStopFunc
-
-		a.options.OnStopFunc = func() {
-			if prevOnStopFunc != nil {
-				prevOnStopFunc()
-			}
-
-			onStopFunc()
+func wrapActors(
+	actors []Actor,
+	onStopFunc func(reason error),
+) []Actor {
+	for i, a := range actors {
+		if h, ok := a.(stopHooker); ok {
+			h.onStopHook(onStopFunc)
+			continue
 		}
 
-		return a
-	}
-
-	wrapActorInterface := func(a Actor) Actor {
-		return &wrappedActor{
+		actors[i] = &wrappedActor{
 			actor:      a,
 			onStopFunc: onStopFunc,
 		}
 	}
 
-	for i, a := range actors {
-		switch v := a.(type) {
-		case *actor:
-			actors[i] = wrapActorStruct(v)
-		case *combinedActor:
-			actors[i] = wrapCombinedActorStruct(v)
-		default:
-			actors[i] = wrapActorInterface(v)
-		}
-	}
-
 	return actors
 }
 
 type wrappedActor struct {
 	actor      Actor
-	onStopFunc func()
+	onStopFunc func(reason error)
 }
 
 func (a *wrappedActor) Start() {
@@ -228,7 +311,7 @@ func (a *wrappedActor) Start() {
 
 func (a *wrappedActor) Stop() {
 	a.actor.Stop()
-	a.onStopFunc()
+	a.onStopFunc(ErrStopped)
 }
 
 func combinedOptionsToRegularList(combined optionsCombined) []Option {
@@ -239,7 +322,7 @@ func combinedOptionsToRegularList(combined optionsCombined) []Option {
 	}
 
 	if fn := combined.OnStopFunc; fn != nil {
-		options = append(options, OptOnStop(fn))
+		options = append(options, OptOnStopWithReason(fn))
 	}
 
 	return options
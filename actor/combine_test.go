@@ -287,6 +287,136 @@ func Test_Combine_StopParallel(t *testing.T) {
 	})
 }
 
+// Test asserts that combined actor reports correct termination reason.
+func Test_Combine_Reason(t *testing.T) {
+	t.Parallel()
+
+	{ // Reason should be ErrStopped when stopped by Stop()
+		reasonC := make(chan error, 1)
+		a := Combine(createActors(3)...).
+			WithOptions(OptOnStopCombinedWithReason(func(r error) { reasonC <- r })).
+			Build()
+		assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+		a.Start()
+		assert.NoError(t, a.(Reasoner).Reason()) //nolint:forcetypeassert // relax
+
+		a.Stop()
+		assert.ErrorIs(t, <-reasonC, ErrStopped)
+		assert.ErrorIs(t, a.(Reasoner).Reason(), ErrStopped) //nolint:forcetypeassert // relax
+	}
+
+	{ // Reason should be reason of first actor that has ended
+		endC := make(chan any)
+		reasonC := make(chan error, 1)
+		actors := append(createActors(3), New(NewWorker(func(ctx Context) WorkerStatus {
+			select {
+			case <-ctx.Done():
+			case <-endC:
+			}
+
+			return WorkerEnd
+		})))
+		a := Combine(actors...).
+			WithOptions(
+				OptStopTogether(),
+				OptOnStopCombinedWithReason(func(r error) { reasonC <- r }),
+			).
+			Build()
+
+		a.Start()
+		close(endC)
+		assert.ErrorIs(t, <-reasonC, ErrWorkerEnded)
+
+		a.Stop()
+
+		reason := a.(Reasoner).Reason() //nolint:forcetypeassert // relax
+		assert.ErrorIs(t, reason, ErrWorkerEnded)
+	}
+}
+
+// Test asserts that Done channel of combined actor is closed when
+// all actors have ended.
+func Test_Combine_Waiter(t *testing.T) {
+	t.Parallel()
+
+	{ // Combined actor is stopped
+		a := Combine(createActors(10)...).Build()
+		w := a.(Waiter) //nolint:forcetypeassert // relax
+
+		for range 3 {
+			a.Start()
+			doneC := w.Done()
+			assertNotDone(t, doneC)
+
+			a.Stop()
+			w.Wait()
+			assertDone(t, doneC)
+		}
+	}
+
+	{ // All combined actors have ended on their own
+		endC := make(chan any)
+		actors := make([]Actor, 10)
+
+		for i := range actors {
+			actors[i] = New(NewWorker(func(ctx Context) WorkerStatus {
+				select {
+				case <-ctx.Done():
+				case <-endC:
+				}
+
+				return WorkerEnd
+			}))
+		}
+
+		a := Combine(actors...).Build()
+		w := a.(Waiter) //nolint:forcetypeassert // relax
+
+		a.Start()
+		assertNotDone(t, w.Done())
+
+		close(endC)
+		w.Wait()
+		assertDone(t, w.Done())
+	}
+}
+
+// Test asserts that combined actor is reported as stopped
+// only after OptOnStopCombined function has returned.
+func Test_Combine_WaitOnStop(t *testing.T) {
+	t.Parallel()
+
+	onStopC := make(chan any)
+	releaseC := make(chan any)
+	a := Combine(createActors(3)...).
+		WithOptions(OptOnStopCombined(func() {
+			close(onStopC)
+			<-releaseC
+		})).
+		Build()
+	w := a.(Waiter) //nolint:forcetypeassert // relax
+
+	a.Start()
+
+	stoppedC := make(chan any)
+
+	go func() {
+		a.Stop()
+		close(stoppedC)
+	}()
+
+	assertSignal(t, onStopC)
+	assertNotDone(t, w.Done())
+	assertNoSignal(t, stoppedC)
+	assert.Equal(t, StateStopping, a.(Stateful).State()) //nolint:forcetypeassert // relax
+
+	close(releaseC)
+	w.Wait()
+	assertSignal(t, stoppedC)
+	assert.Equal(t, StateStopped, a.(Stateful).State()) //nolint:forcetypeassert // relax
+}
+
 // Test asserts that wrapActors is correctly wrapping actors with onStopFunc callback.
 func Test_Combine_WrapActors(t *testing.T) {
 	t.Parallel()
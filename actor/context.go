@@ -21,6 +21,10 @@ import (
 type Context = gocontext.Context
 
 // ErrStopped is the error returned by Context.Err when the Actor is stopped.
+//
+// When the Actor was created with OptContext, Context.Err returns the error
+// of the parent context if the parent context has ended before the Actor
+// was stopped.
 var ErrStopped = errors.New("actor stopped")
 
 //nolint:gochecknoglobals // these are singleton values
@@ -56,9 +60,12 @@ func ContextEnded() Context {
 }
 
 type context struct {
-	stopped atomic.Bool
-	once    sync.Once
-	doneC   chan struct{}
+	parent     gocontext.Context //nolint:containedctx // parent is needed for values
+	stopParent func() bool
+	stopped    atomic.Bool
+	err        error
+	once       sync.Once
+	doneC      chan struct{}
 }
 
 func newContext() *context {
@@ -67,12 +74,49 @@ func newContext() *context {
 	}
 }
 
+// newContextWithParent returns new context which inherits values
+// and deadline from parent, and which ends when parent ends.
+func newContextWithParent(parent gocontext.Context) *context {
+	c := newContext()
+
+	if parent == nil {
+		return c
+	}
+
+	c.parent = parent
+
+	if err := parent.Err(); err != nil {
+		c.endWithErr(err)
+		return c
+	}
+
+	c.stopParent = gocontext.AfterFunc(parent, func() {
+		c.endWithErr(parent.Err())
+	})
+
+	return c
+}
+
 func (c *context) Deadline() (time.Time, bool) {
+	if c.parent != nil {
+		return c.parent.Deadline()
+	}
+
 	return time.Time{}, false
 }
 
 func (c *context) end() {
+	c.endWithErr(ErrStopped)
+
+	// release resources associated with parent context
+	if c.stopParent != nil {
+		c.stopParent()
+	}
+}
+
+func (c *context) endWithErr(err error) {
 	c.once.Do(func() {
+		c.err = err
 		c.stopped.Store(true)
 		close(c.doneC)
 	})
@@ -84,14 +128,17 @@ func (c *context) Done() <-chan struct{} {
 
 func (c *context) Err() error {
 	if c.stopped.Load() {
-		return ErrStopped
+		return c.err
 	}
 
 	return nil
 }
 
-//nolint:revive // needed to satisfy context.Context interface
-func (*context) Value(key any) any {
+func (c *context) Value(key any) any {
+	if c.parent != nil {
+		return c.parent.Value(key)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package actor_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -71,6 +72,67 @@ func Test_Context_Value(t *testing.T) {
 	assertNoValue(t, ContextStarted())
 }
 
+func Test_Context_Parent(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey struct{}
+
+	deadline := time.Now().Add(time.Hour)
+
+	newParent := func() (Context, context.CancelFunc) {
+		parent, cancel := context.WithDeadline(context.Background(), deadline)
+		return context.WithValue(parent, ctxKey{}, `🔑`), cancel
+	}
+
+	{ // Context inherits values and deadline from parent
+		parent, cancel := newParent()
+		defer cancel()
+
+		ctx := NewContextWithParent(parent)
+		assertContextStarted(t, ctx)
+		assertContextStringer(t, ctx)
+		assert.Equal(t, `🔑`, ctx.Value(ctxKey{}))
+		assert.Nil(t, ctx.Value(&deadline))
+
+		d, ok := ctx.Deadline()
+		assert.Equal(t, deadline, d)
+		assert.True(t, ok)
+
+		// Ending context should not end parent
+		ctx.End()
+		assertContextEnded(t, ctx)
+		assert.NoError(t, parent.Err())
+	}
+
+	{ // Context ends when parent ends
+		parent, cancel := newParent()
+		ctx := NewContextWithParent(parent)
+
+		cancel()
+		<-ctx.Done()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+		// Ending context afterwards should not change error
+		ctx.End()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	}
+
+	{ // Context is ended if parent has ended before
+		parent, cancel := newParent()
+		cancel()
+
+		ctx := NewContextWithParent(parent)
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	}
+
+	{ // Context without parent
+		ctx := NewContextWithParent(nil)
+		assertContextStarted(t, ctx)
+		assertNoDeadline(t, ctx)
+		assertNoValue(t, ctx)
+	}
+}
+
 func assertContextStarted(t *testing.T, ctx Context) {
 	t.Helper()
 
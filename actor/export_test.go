@@ -24,20 +24,24 @@ func (a *ActorImpl) OnStart() {
 }
 
 func (a *ActorImpl) OnStop() {
-	a.onStop()
+	a.onStop(ErrStopped)
 }
 
 func WrapActors(
 	actors []Actor,
 	onStopFunc func(),
 ) []Actor {
-	return wrapActors(actors, onStopFunc)
+	return wrapActors(actors, func(error) { onStopFunc() })
 }
 
 func NewContext() *context {
 	return newContext()
 }
 
+func NewContextWithParent(parent Context) *context {
+	return newContextWithParent(parent)
+}
+
 func (c *context) End() {
 	c.end()
 }
@@ -66,7 +70,7 @@ func NewMailboxWorker[T any](
 	return newMailboxWorker(sendC, receiveC, mOpts)
 }
 
-func (w *mailboxWorker[T]) Queue() *queue[T] {
+func (w *mailboxWorker[T]) Queue() messageQueue[T] {
 	return w.queue
 }
 
@@ -74,6 +78,10 @@ func NewQueue[T any](capacity int) *queue[T] {
 	return newQueue[T](capacity)
 }
 
+func NewPriorityQueue[T any](less func(a, b T) bool, capacity int) *priorityQueue[T] {
+	return newPriorityQueue(less, capacity)
+}
+
 func (q *queue[T]) Cap() int {
 	return q.q.Cap()
 }
@@ -0,0 +1,88 @@
+package actor
+
+import (
+	gocontext "context"
+	"sync"
+)
+
+// NewFanOut returns an Actor which forwards every message received from
+// receiveC to all senders.
+//
+// Unlike FanOut, sending is performed within the Actor, using its Context,
+// therefore sending ends once the Actor is stopped. The Actor ends when
+// receiveC is closed, for example when the Mailbox it belongs to is stopped.
+//
+// By default, a message is sent to senders one by one, in the order they are
+// supplied, and the next message is received once all senders have accepted it.
+// OptSendConcurrently makes the Actor send a message to all senders at once,
+// so that a slow sender does not delay sending to others, while OptSendTimeout
+// limits how long sending to a single sender may take. Errors returned by
+// senders, including errors caused by timeout, are reported to the function
+// supplied with OptOnSendError.
+func NewFanOut[T any, MS MailboxSender[T]](
+	receiveC <-chan T,
+	senders []MS,
+	opt ...FanOutOption,
+) Actor {
+	return New(&fanOutWorker[T, MS]{
+		receiveC: receiveC,
+		senders:  senders,
+		options:  newOptions(opt).FanOut,
+	})
+}
+
+type fanOutWorker[T any, MS MailboxSender[T]] struct {
+	receiveC <-chan T
+	senders  []MS
+	options  optionsFanOut
+}
+
+func (w *fanOutWorker[T, MS]) DoWork(ctx Context) WorkerStatus {
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+
+	case msg, ok := <-w.receiveC:
+		if !ok {
+			return WorkerEnd
+		}
+
+		if w.options.Concurrent {
+			w.sendConcurrently(ctx, msg)
+		} else {
+			for i := range w.senders {
+				w.send(ctx, i, msg)
+			}
+		}
+
+		return WorkerContinue
+	}
+}
+
+func (w *fanOutWorker[T, MS]) sendConcurrently(ctx Context, msg T) {
+	wg := sync.WaitGroup{}
+	wg.Add(len(w.senders))
+
+	for i := range w.senders {
+		go func() {
+			defer wg.Done()
+			w.send(ctx, i, msg)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (w *fanOutWorker[T, MS]) send(ctx Context, i int, msg T) {
+	if timeout := w.options.SendTimeout; timeout > 0 {
+		var cancel gocontext.CancelFunc
+
+		ctx, cancel = gocontext.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	err := w.senders[i].Send(ctx, msg)
+	if err != nil && w.options.OnSendErrorFunc != nil {
+		w.options.OnSendErrorFunc(i, msg, err)
+	}
+}
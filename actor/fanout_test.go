@@ -0,0 +1,152 @@
+package actor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+type sendError struct {
+	sender int
+	msg    any
+	err    error
+}
+
+// Test asserts that fan out actor sends all messages to all senders.
+func Test_NewFanOut(t *testing.T) {
+	t.Parallel()
+
+	test := func(t *testing.T, opt ...FanOutOption) {
+		t.Helper()
+
+		const (
+			sendMessagesCount = 100
+			fanOutCount       = 5
+		)
+
+		inMbx := NewMailbox[any]()
+		fanMbxx := NewMailboxes[any](fanOutCount)
+		fanOut := NewFanOut(inMbx.ReceiveC(), fanMbxx, opt...)
+
+		a := Combine(inMbx, fanOut, FromMailboxes(fanMbxx)).Build()
+
+		a.Start()
+		defer a.Stop()
+
+		for i := range sendMessagesCount {
+			assert.NoError(t, inMbx.Send(ContextStarted(), i))
+		}
+
+		for _, m := range fanMbxx {
+			for i := range sendMessagesCount {
+				assert.Equal(t, i, <-m.ReceiveC())
+			}
+		}
+
+		// Fan out actor should end when its mailbox is stopped
+		inMbx.Stop()
+		assertDone(t, fanOut.(Waiter).Done()) //nolint:forcetypeassert // relax
+	}
+
+	test(t)
+	test(t, OptSendConcurrently())
+}
+
+// Test asserts that fan out actor reports senders which have not accepted
+// message within timeout, without affecting other senders.
+func Test_NewFanOut_SendTimeout(t *testing.T) {
+	t.Parallel()
+
+	test := func(t *testing.T, opt ...FanOutOption) {
+		t.Helper()
+
+		errorsC := make(chan sendError, 10)
+		inMbx := NewMailbox[any]()
+
+		// Messages are never received from stuck mailbox, which holds one message
+		stuckMbx := NewMailbox[any](OptMaxLen(1))
+		fanMbx := NewMailbox[any]()
+
+		opt = append(opt,
+			OptSendTimeout(10*time.Millisecond),
+			OptOnSendError(func(sender int, msg any, err error) {
+				errorsC <- sendError{sender, msg, err}
+			}),
+		)
+		fanOut := NewFanOut(inMbx.ReceiveC(), []Mailbox[any]{stuckMbx, fanMbx}, opt...)
+
+		a := Combine(inMbx, fanOut, stuckMbx, fanMbx).Build()
+
+		a.Start()
+		defer a.Stop()
+
+		for i := range 3 {
+			assert.NoError(t, inMbx.Send(ContextStarted(), i))
+		}
+
+		for i := range 3 {
+			assert.Equal(t, i, <-fanMbx.ReceiveC())
+		}
+
+		for i := 1; i < 3; i++ {
+			e := <-errorsC
+			assert.Equal(t, 0, e.sender)
+			assert.Equal(t, i, e.msg)
+			assert.ErrorIs(t, e.err, context.DeadlineExceeded)
+		}
+
+		assert.Equal(t, 0, <-stuckMbx.ReceiveC())
+	}
+
+	test(t)
+	test(t, OptSendConcurrently())
+}
+
+// Test asserts that fan out actor, which is sending message, can be stopped.
+func Test_NewFanOut_Stop(t *testing.T) {
+	t.Parallel()
+
+	errorsC := make(chan sendError, 1)
+	inMbx := NewMailbox[any]()
+	stuckMbx := NewMailbox[any](OptMaxLen(1))
+
+	fanOut := NewFanOut(inMbx.ReceiveC(), []Mailbox[any]{stuckMbx},
+		OptOnSendError(func(sender int, msg any, err error) {
+			errorsC <- sendError{sender, msg, err}
+		}),
+	)
+
+	inMbx.Start()
+	stuckMbx.Start()
+	fanOut.Start()
+
+	assert.NoError(t, inMbx.Send(ContextStarted(), 1))
+	assert.NoError(t, inMbx.Send(ContextStarted(), 2))
+
+	// Fan out actor is stopped while it is blocked sending second message
+	stats := inMbx.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+	assert.Eventually(t, func() bool {
+		return stats.Stats().Received == 2
+	}, time.Second, time.Millisecond)
+
+	fanOut.Stop()
+
+	e := <-errorsC
+	assert.Equal(t, 2, e.msg)
+	assert.ErrorIs(t, e.err, ErrStopped)
+
+	inMbx.Stop()
+	stuckMbx.Stop()
+}
+
+func Test_NewFanOut_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return NewFanOut(NewMailbox[any]().ReceiveC(), NewMailboxes[any](2))
+	})
+}
@@ -3,13 +3,43 @@ package actor
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
-// ErrMailboxStopped is an error returned by Mailbox.Send when sending is performed
-// on a mailbox that has been stopped.
-var ErrMailboxStopped = errors.New("Mailbox is stopped")
+var (
+	// ErrMailboxStopped is an error returned by Mailbox.Send when sending is performed
+	// on a mailbox that has been stopped.
+	ErrMailboxStopped = errors.New("Mailbox is stopped")
+
+	// ErrMailboxFull is an error returned by Mailbox.Send when sending is performed
+	// on a mailbox that has reached its maximum length, and which is configured
+	// with OverflowError policy. It is also returned by Mailbox.TrySend when
+	// message could not be accepted immediately, because the mailbox is full.
+	ErrMailboxFull = errors.New("Mailbox is full")
+)
+
+// OverflowPolicy defines how a Mailbox, whose length is limited with OptMaxLen,
+// handles messages sent after it has reached its maximum length.
+type OverflowPolicy int8
+
+const (
+	// OverflowBlock blocks the sender until there is space in the Mailbox.
+	OverflowBlock OverflowPolicy = 0
+
+	// OverflowDropNewest discards the message being sent.
+	OverflowDropNewest OverflowPolicy = 1
+
+	// OverflowDropOldest discards the oldest message in the Mailbox
+	// to make space for the message being sent. Mailbox returned by
+	// NewPriorityMailbox discards the message with the lowest priority instead.
+	OverflowDropOldest OverflowPolicy = 2
+
+	// OverflowError makes Send fail with ErrMailboxFull.
+	OverflowError OverflowPolicy = 3
+)
 
 // Mailbox is interface for message transport mechanism between Actors.
 type Mailbox[T any] interface {
@@ -23,6 +53,26 @@ type MailboxSender[T any] interface {
 	// Send sends a message via the mailbox.
 	// Returns error if message could not be sent.
 	Send(ctx Context, msg T) error
+
+	// TrySend sends a message via the mailbox without blocking.
+	// Returns ErrMailboxFull if the message could not be accepted immediately,
+	// or other error if message could not be sent.
+	//
+	// Mailboxes created with NewMailbox report that they are full only when
+	// they hold the maximum number of messages set with OptMaxLen, and are
+	// configured with OverflowBlock or OverflowError policy. Other policies
+	// are applied to the message as with Send. TrySend never waits for the
+	// mailbox, even when it has not been started yet.
+	TrySend(msg T) error
+
+	// SendBatch sends all messages via the mailbox, in the order they are
+	// supplied. Returns error if messages could not be sent.
+	//
+	// Mailboxes created with NewMailbox accept the whole batch at once,
+	// therefore either all messages are sent or none of them, and no other
+	// messages are interleaved with them. The supplied slice can be reused
+	// once SendBatch has returned.
+	SendBatch(ctx Context, msgs []T) error
 }
 
 // MailboxReceiver is an interface that defines the receiving capabilities of a Mailbox.
@@ -55,6 +105,10 @@ func FromMailboxes[T any](mm []Mailbox[T]) Actor {
 // slice. The spawned goroutine will remain active as long as the receiveC
 // channel is open, ensuring that all messages are dispatched until the
 // channel is closed.
+//
+// Errors returned by senders are ignored, and the goroutine can not be
+// stopped otherwise. NewFanOut returns an Actor which can be stopped,
+// and which reports errors instead.
 func FanOut[T any, MS MailboxSender[T]](receiveC <-chan T, senders []MS) {
 	ctx := ContextStarted()
 
@@ -90,6 +144,10 @@ func NewMailboxes[T any](count int, opt ...MailboxOption) []Mailbox[T] {
 // sent to the Mailbox are queued without limitations, ensuring that send
 // operations will never cause the sender to wait.
 //
+// When the OptMaxLen option is used, the number of messages held by the Mailbox
+// is limited, and messages sent after this limit is reached are handled
+// according to the policy supplied with OptOverflow.
+//
 // When the OptAsChan option is used, the Mailbox can behave identically
 // to a native Go channel (buffered or unbuffered) with key exception that
 // sending and receiving from this kind of mailbox will never panic.
@@ -109,6 +167,10 @@ func NewMailboxes[T any](count int, opt ...MailboxOption) []Mailbox[T] {
 // unchanged. Once the mailbox is stopped, this channel will be closed, signaling that
 // no more messages will be received. Programs can use this as an indicator that
 // the mailbox is no longer active.
+//
+// Returned Mailbox implements Waiter and Stateful interfaces, which can be used
+// to observe when the mailbox has stopped, and MailboxStatsReporter interface,
+// which can be used to observe how backed up the mailbox is.
 func NewMailbox[T any](opt ...MailboxOption) Mailbox[T] {
 	options := newOptions(opt).Mailbox
 
@@ -124,50 +186,77 @@ const (
 	minQueueCapacity = mbxChanBufferCap
 )
 
-type mbxState = int32
-
-const (
-	mbxStateNotStarted mbxState = 0
-	mbxStateRunning    mbxState = 1
-	mbxStateStopped    mbxState = 2
-)
-
 func newMailboxChan[T any](options optionsMailbox) *mailboxChan[T] {
 	return &mailboxChan[T]{
 		c:           make(chan T, options.Capacity),
 		stopSigC:    make(chan struct{}),
-		state:       &atomic.Int32{},
 		ongoingSend: &atomic.Int64{},
+		deadLetters: options.DeadLetters,
 	}
 }
 
 type mailboxChan[T any] struct {
 	c           chan T
 	stopSigC    chan struct{}
-	state       *atomic.Int32
+	state       stateTracker
 	ongoingSend *atomic.Int64
 	closeOnce   sync.Once
+	stats       mbxStats
+	deadLetters MailboxSender[DeadLetter]
 }
 
 func (m *mailboxChan[T]) Start() {
-	m.state.CompareAndSwap(mbxStateNotStarted, mbxStateRunning)
+	if m.state.compareAndSet(StateNotStarted, StateRunning) {
+		m.state.notify()
+	}
 }
 
 func (m *mailboxChan[T]) Stop() {
-	if m.state.CompareAndSwap(mbxStateRunning, mbxStateStopped) {
+	if m.state.compareAndSet(StateRunning, StateStopping) {
+		m.state.notify()
+
 		close(m.stopSigC)
 		m.closeReceiveC()
+
+		m.state.set(StateStopped)
+		m.state.notify()
 	}
 }
 
+func (m *mailboxChan[T]) StopContext(Context) error {
+	// stopping this mailbox never blocks
+	m.Stop()
+	return nil
+}
+
 func (m *mailboxChan[T]) Send(ctx Context, msg T) error {
-	state := m.state.Load()
-	if state == mbxStateStopped {
+	if err := m.send(ctx, msg); err != nil {
+		sendDeadLetters(m.deadLetters, err, msg)
+		return err
+	}
+
+	return nil
+}
+
+func (m *mailboxChan[T]) send(ctx Context, msg T) error {
+	if m.state.get() >= StateStopping {
 		return fmt.Errorf("Mailbox.Send failed: %w", ErrMailboxStopped)
 	}
 
 	m.ongoingSend.Add(1)
 
+	select {
+	case m.c <- msg:
+		m.ongoingSend.Add(-1)
+		m.onSent()
+
+		return nil
+	case <-ctx.Done():
+		// ended context is handled below, same as when sending blocks
+	default:
+		defer m.stats.sendBlockedSince(time.Now())
+	}
+
 	select {
 	case <-m.stopSigC:
 		if m.ongoingSend.Add(-1) == 0 {
@@ -180,10 +269,66 @@ func (m *mailboxChan[T]) Send(ctx Context, msg T) error {
 		return fmt.Errorf("Mailbox.Send canceled: %w", ctx.Err())
 	case m.c <- msg:
 		m.ongoingSend.Add(-1)
+		m.onSent()
+
+		return nil
+	}
+}
+
+func (m *mailboxChan[T]) TrySend(msg T) error {
+	if err := m.trySend(msg); err != nil {
+		sendDeadLetters(m.deadLetters, err, msg)
+		return err
+	}
+
+	return nil
+}
+
+func (m *mailboxChan[T]) trySend(msg T) error {
+	if m.state.get() >= StateStopping {
+		return fmt.Errorf("Mailbox.TrySend failed: %w", ErrMailboxStopped)
+	}
+
+	m.ongoingSend.Add(1)
+
+	select {
+	case <-m.stopSigC:
+		if m.ongoingSend.Add(-1) == 0 {
+			m.closeOnce.Do(func() { close(m.c) })
+		}
+
+		return fmt.Errorf("Mailbox.TrySend canceled: %w", ErrMailboxStopped)
+	case m.c <- msg:
+		m.ongoingSend.Add(-1)
+		m.onSent()
+
 		return nil
+	default:
+		m.ongoingSend.Add(-1)
+		return fmt.Errorf("Mailbox.TrySend failed: %w", ErrMailboxFull)
 	}
 }
 
+// SendBatch sends messages one by one, therefore, unlike with other
+// mailboxes, some of the messages could be sent even if error is returned.
+func (m *mailboxChan[T]) SendBatch(ctx Context, msgs []T) error {
+	for i, msg := range msgs {
+		if err := m.send(ctx, msg); err != nil {
+			sendDeadLetters(m.deadLetters, err, msgs[i:]...)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// onSent counts message sent to channel, which is delivered to
+// receivers as soon as it is sent.
+func (m *mailboxChan[T]) onSent() {
+	m.stats.delivered.Add(1)
+	m.stats.onSent(1, len(m.c))
+}
+
 func (m *mailboxChan[T]) closeReceiveC() {
 	if m.ongoingSend.Load() == 0 {
 		m.closeOnce.Do(func() { close(m.c) })
@@ -194,69 +339,347 @@ func (m *mailboxChan[T]) ReceiveC() <-chan T {
 	return m.c
 }
 
+func (m *mailboxChan[T]) Done() <-chan struct{} {
+	return m.stopSigC
+}
+
+func (m *mailboxChan[T]) Wait() {
+	<-m.stopSigC
+}
+
+func (m *mailboxChan[T]) State() State {
+	return m.state.get()
+}
+
+func (m *mailboxChan[T]) SubscribeState(fn func(State)) func() {
+	return m.state.subscribe(fn)
+}
+
+func (m *mailboxChan[T]) Stats() MailboxStats {
+	return m.stats.snapshot(len(m.c), cap(m.c))
+}
+
+// NewPriorityMailbox returns a new local Mailbox implementation which delivers
+// messages ordered by priority.
+//
+// The supplied less function reports whether message a has higher priority
+// than message b. The message with the highest priority among messages held
+// by the Mailbox is received first, while messages of equal priority are
+// received in the order they were sent.
+//
+// Apart from ordering of messages, the returned Mailbox behaves the same as
+// the default Mailbox returned by NewMailbox, and it is configured with the same
+// options, except OptAsChan, which is ignored. When used with OptMaxLen and
+// OverflowDropOldest policy, the message with the lowest priority, which would
+// be received last, is discarded once the Mailbox is full. This can be
+// the message being sent, if its priority is lower than priorities of all
+// messages held by the Mailbox.
+func NewPriorityMailbox[T any](
+	less func(a, b T) bool,
+	opt ...MailboxOption,
+) Mailbox[T] {
+	options := newOptions(opt).Mailbox
+
+	// unbuffered receive channel is used so that
+	// all messages are ordered by priority queue
+	return newMailboxWithQueue(options, newPriorityQueue(less, options.Capacity), 0)
+}
+
 func newMailbox[T any](options optionsMailbox) *mailbox[T] {
+	chanCap := mbxChanBufferCap
+
+	// bounded mailbox uses unbuffered receive channel so that all
+	// messages it holds are in the queue, unless they are counted by slots
+	if options.MaxLen > 0 {
+		chanCap = 0
+	}
+
+	return newMailboxWithQueue(options, newQueue[T](options.Capacity), chanCap)
+}
+
+func newMailboxWithQueue[T any](
+	options optionsMailbox,
+	q messageQueue[T],
+	chanCap int,
+) *mailbox[T] {
 	var (
-		sendC    = make(chan T, mbxChanBufferCap)
-		receiveC = make(chan T, mbxChanBufferCap)
+		slots    = newMbxSlots(options)
+		receiveC = make(chan T, chanCap)
+		worker   = newMailboxWorker(sendC[T](slots), receiveC, options)
 	)
 
+	worker.queue = q
+	worker.slots = slots
+	worker.inbox = newMbxInbox[T](slots)
+
 	return &mailbox[T]{
-		actor:    New(newMailboxWorker(sendC, receiveC, options)),
-		sendC:    sendC,
-		receiveC: receiveC,
-		stopSigC: make(chan struct{}),
-		state:    &atomic.Int32{},
+		mailboxCore: newMailboxCore(worker, worker),
+		receiveC:    receiveC,
 	}
 }
 
 type mailbox[T any] struct {
-	actor    Actor
-	sendC    chan T
+	mailboxCore[T]
 	receiveC <-chan T
-	stopSigC chan struct{}
-	state    *atomic.Int32
 }
 
-func (m *mailbox[T]) Start() {
-	if m.state.CompareAndSwap(mbxStateNotStarted, mbxStateRunning) {
+func (m *mailbox[T]) ReceiveC() <-chan T {
+	return m.receiveC
+}
+
+// mailboxCore implements lifecycle and sending capabilities
+// shared by mailboxes backed by mailboxWorker.
+type mailboxCore[T any] struct {
+	actor       *actor
+	sendC       chan T
+	sendBatchC  chan []T
+	stopSigC    chan struct{}
+	state       stateTracker
+	slots       *mbxSlots
+	inbox       *mbxInbox[T]
+	stats       *mbxStats
+	receiveC    chan T
+	maxLen      int
+	blocking    bool
+	deadLetters MailboxSender[DeadLetter]
+}
+
+func newMailboxCore[T any](w Worker, mw *mailboxWorker[T]) mailboxCore[T] {
+	return mailboxCore[T]{
+		actor:       newActor(w, optionsActor{}),
+		sendC:       mw.sendC,
+		sendBatchC:  mw.sendBatchC,
+		stopSigC:    make(chan struct{}),
+		slots:       mw.slots,
+		inbox:       mw.inbox,
+		stats:       mw.stats,
+		receiveC:    mw.receiveC,
+		maxLen:      mw.options.MaxLen,
+		blocking:    mw.options.Overflow == OverflowBlock,
+		deadLetters: mw.options.DeadLetters,
+	}
+}
+
+func (m *mailboxCore[T]) Start() {
+	if m.state.compareAndSet(StateNotStarted, StateRunning) {
 		m.actor.Start()
+		m.state.notify()
 	}
 }
 
-func (m *mailbox[T]) Stop() {
-	if m.state.CompareAndSwap(mbxStateRunning, mbxStateStopped) {
+func (m *mailboxCore[T]) Stop() {
+	if m.state.compareAndSet(StateRunning, StateStopping) {
+		m.state.notify()
+
 		close(m.stopSigC)
 		m.actor.Stop()
+
+		m.state.set(StateStopped)
+		m.state.notify()
 	}
 }
 
-func (m *mailbox[T]) Send(ctx Context, msg T) error {
-	state := m.state.Load()
-	if state == mbxStateStopped {
+func (m *mailboxCore[T]) StopContext(ctx Context) error {
+	return stopWithContext(ctx, m.Stop)
+}
+
+func (m *mailboxCore[T]) Send(ctx Context, msg T) error {
+	if err := m.send(ctx, msg); err != nil {
+		sendDeadLetters(m.deadLetters, err, msg)
+		return err
+	}
+
+	return nil
+}
+
+func (m *mailboxCore[T]) send(ctx Context, msg T) error {
+	if m.state.get() >= StateStopping {
 		return fmt.Errorf("Mailbox.Send failed: %w", ErrMailboxStopped)
 	}
 
+	if m.inbox != nil {
+		if err := m.put(ctx, msg); err != nil {
+			return fmt.Errorf("Mailbox.Send canceled: %w", err)
+		}
+
+		return nil
+	}
+
+	if !m.slots.acquire(1) {
+		if !m.blocking {
+			return fmt.Errorf("Mailbox.Send failed: %w", ErrMailboxFull)
+		}
+
+		if err := m.waitSlots(ctx, 1); err != nil {
+			return fmt.Errorf("Mailbox.Send canceled: %w", err)
+		}
+	}
+
+	// message which has acquired slot always fits into sendC,
+	// therefore only waiting for slots is counted as blocked
 	select {
 	case <-m.stopSigC:
 		// this block can potentially not be covered with tests because of race condition.
 		// it can cause flakiness with CI.
+		m.slots.release(1)
 		return fmt.Errorf("Mailbox.Send canceled: %w", ErrMailboxStopped)
 	case <-ctx.Done():
+		m.slots.release(1)
 		return fmt.Errorf("Mailbox.Send canceled: %w", ctx.Err())
 	case m.sendC <- msg:
+		m.stats.onSent(1, len(m.receiveC))
 		return nil
 	}
 }
 
-func (m *mailbox[T]) ReceiveC() <-chan T {
-	return m.receiveC
+func (m *mailboxCore[T]) TrySend(msg T) error {
+	if err := m.trySend(msg); err != nil {
+		sendDeadLetters(m.deadLetters, err, msg)
+		return err
+	}
+
+	return nil
+}
+
+func (m *mailboxCore[T]) trySend(msg T) error {
+	if m.state.get() >= StateStopping {
+		return fmt.Errorf("Mailbox.TrySend failed: %w", ErrMailboxStopped)
+	}
+
+	if m.inbox != nil {
+		if err := m.put(ContextStarted(), msg); err != nil {
+			return fmt.Errorf("Mailbox.TrySend canceled: %w", err)
+		}
+
+		return nil
+	}
+
+	if !m.slots.acquire(1) {
+		return fmt.Errorf("Mailbox.TrySend failed: %w", ErrMailboxFull)
+	}
+
+	// message which has acquired slot always fits into sendC,
+	// therefore this send does not wait for mailbox worker
+	select {
+	case m.sendC <- msg:
+		m.stats.onSent(1, len(m.receiveC))
+		return nil
+	default:
+		m.slots.release(1)
+		return fmt.Errorf("Mailbox.TrySend failed: %w", ErrMailboxFull)
+	}
+}
+
+// put hands messages over to inbox of mailbox which accepts all messages.
+func (m *mailboxCore[T]) put(ctx Context, msgs ...T) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck // error is wrapped by caller
+	default:
+	}
+
+	if !m.inbox.put(msgs) {
+		return ErrMailboxStopped
+	}
+
+	m.stats.onSent(len(msgs), len(m.receiveC))
+
+	return nil
+}
+
+// waitSlots waits until n slots are acquired by sender,
+// when mailbox with OverflowBlock policy is full.
+func (m *mailboxCore[T]) waitSlots(ctx Context, n int) error {
+	defer m.stats.sendBlockedSince(time.Now())
+
+	return m.slots.wait(ctx, m.stopSigC, n)
+}
+
+func (m *mailboxCore[T]) SendBatch(ctx Context, msgs []T) error {
+	if err := m.sendBatch(ctx, msgs); err != nil {
+		sendDeadLetters(m.deadLetters, err, msgs...)
+		return err
+	}
+
+	return nil
+}
+
+func (m *mailboxCore[T]) sendBatch(ctx Context, msgs []T) error {
+	if m.state.get() >= StateStopping {
+		return fmt.Errorf("Mailbox.SendBatch failed: %w", ErrMailboxStopped)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if m.inbox != nil {
+		if err := m.put(ctx, msgs...); err != nil {
+			return fmt.Errorf("Mailbox.SendBatch canceled: %w", err)
+		}
+
+		return nil
+	}
+
+	if !m.slots.acquire(len(msgs)) {
+		if !m.blocking {
+			return fmt.Errorf("Mailbox.SendBatch failed: %w", ErrMailboxFull)
+		}
+
+		if err := m.waitSlots(ctx, len(msgs)); err != nil {
+			return fmt.Errorf("Mailbox.SendBatch canceled: %w", err)
+		}
+	}
+
+	// batch is copied because worker could hold it after SendBatch has returned
+	batch := slices.Clone(msgs)
+
+	// worker accepts batch which has acquired slots without waiting
+	// for receivers, therefore handing it over is not counted as blocked
+	select {
+	case <-m.stopSigC:
+		m.slots.release(len(msgs))
+		return fmt.Errorf("Mailbox.SendBatch canceled: %w", ErrMailboxStopped)
+	case <-ctx.Done():
+		m.slots.release(len(msgs))
+		return fmt.Errorf("Mailbox.SendBatch canceled: %w", ctx.Err())
+	case m.sendBatchC <- batch:
+		m.stats.onSent(len(batch), len(m.receiveC))
+		return nil
+	}
+}
+
+func (m *mailboxCore[T]) Done() <-chan struct{} {
+	return m.actor.Done()
+}
+
+func (m *mailboxCore[T]) Wait() {
+	m.actor.Wait()
+}
+
+func (m *mailboxCore[T]) State() State {
+	return m.state.get()
+}
+
+func (m *mailboxCore[T]) SubscribeState(fn func(State)) func() {
+	return m.state.subscribe(fn)
+}
+
+func (m *mailboxCore[T]) Stats() MailboxStats {
+	return m.stats.snapshot(len(m.receiveC), m.maxLen)
 }
 
 type mailboxWorker[T any] struct {
-	receiveC chan T
-	sendC    chan T
-	options  optionsMailbox
-	queue    *queue[T]
+	receiveC   chan T
+	sendC      chan T
+	sendBatchC chan []T
+	options    optionsMailbox
+	queue      messageQueue[T]
+	pending    []T
+	held       int
+	slots      *mbxSlots
+	inbox      *mbxInbox[T]
+	stats      *mbxStats
 }
 
 func newMailboxWorker[T any](
@@ -265,10 +688,12 @@ func newMailboxWorker[T any](
 	options optionsMailbox,
 ) *mailboxWorker[T] {
 	return &mailboxWorker[T]{
-		sendC:    sendC,
-		receiveC: receiveC,
-		options:  options,
-		queue:    newQueue[T](options.Capacity),
+		sendC:      sendC,
+		receiveC:   receiveC,
+		sendBatchC: make(chan []T),
+		options:    options,
+		queue:      newQueue[T](options.Capacity),
+		stats:      &mbxStats{},
 	}
 }
 
@@ -279,27 +704,169 @@ func (w *mailboxWorker[T]) DoWork(ctx Context) WorkerStatus {
 			return WorkerEnd
 
 		case value := <-w.sendC:
-			if len(w.receiveC) < mbxChanBufferCap {
+			if len(w.receiveC) < cap(w.receiveC) {
 				w.receiveC <- value
+				w.slots.release(1)
+				w.stats.delivered.Add(1)
 			} else {
-				w.queue.PushBack(value)
+				w.push(value)
 			}
 
 			return WorkerContinue
+
+		case batch := <-w.sendBatchC:
+			w.pushBatch(batch)
+			return WorkerContinue
+
+		case <-w.inbox.readyC():
+			w.acceptInbox()
+			return WorkerContinue
 		}
 	}
 
+	// messages waiting in sendC and inbox are queued before the next
+	// message is delivered, so that priority queue can order them
+	w.acceptBuffered()
+
 	select {
 	case <-ctx.Done():
 		return WorkerEnd
 
 	case w.receiveC <- w.queue.Front():
 		w.queue.PopFront()
+		w.slots.release(1)
+		w.stats.delivered.Add(1)
+		w.pushPending()
+
 		return WorkerContinue
 
-	case value := <-w.sendC:
-		w.queue.PushBack(value)
+	case value := <-w.acceptC():
+		w.push(value)
 		return WorkerContinue
+
+	case batch := <-w.acceptBatchC():
+		w.pushBatch(batch)
+		return WorkerContinue
+
+	case <-w.inbox.readyC():
+		w.acceptInbox()
+		return WorkerContinue
+	}
+}
+
+func (w *mailboxWorker[T]) acceptBuffered() {
+	for len(w.sendC) > 0 && w.accepting() {
+		w.push(<-w.sendC)
+	}
+
+	select {
+	case <-w.inbox.readyC():
+		w.acceptInbox()
+	default:
+	}
+}
+
+// acceptInbox pushes all messages taken from inbox, which is used only
+// by mailboxes that accept all messages, therefore it is not limited
+// by accepting.
+func (w *mailboxWorker[T]) acceptInbox() {
+	for _, value := range w.inbox.take() {
+		w.push(value)
+	}
+}
+
+// acceptC returns channel from which worker should accept sent messages,
+// or nil when messages should not be accepted.
+func (w *mailboxWorker[T]) acceptC() chan T {
+	if !w.accepting() {
+		return nil
+	}
+
+	return w.sendC
+}
+
+// acceptBatchC returns channel from which worker should accept sent batches,
+// or nil when batches should not be accepted.
+func (w *mailboxWorker[T]) acceptBatchC() chan []T {
+	if !w.accepting() {
+		return nil
+	}
+
+	return w.sendBatchC
+}
+
+func (w *mailboxWorker[T]) accepting() bool {
+	if len(w.pending) > 0 {
+		return false
+	}
+
+	return w.options.Overflow != OverflowBlock || !w.isFull()
+}
+
+// isFull reports whether mailbox holds maximum number of messages,
+// counting messages in queue and messages held outside of it.
+func (w *mailboxWorker[T]) isFull() bool {
+	return w.options.MaxLen > 0 && w.queue.Len()+w.held >= w.options.MaxLen
+}
+
+func (w *mailboxWorker[T]) push(value T) {
+	if w.isFull() {
+		switch w.options.Overflow {
+		case OverflowDropNewest:
+			w.drop(value)
+			return
+		case OverflowDropOldest:
+			// when all messages are held outside of queue,
+			// the newest message is the only one that can be dropped
+			if w.queue.IsEmpty() {
+				w.drop(value)
+				return
+			}
+
+			// message being sent is pushed first, since
+			// it could be the one which should be discarded
+			w.queue.PushBack(value)
+			w.drop(w.queue.Evict())
+
+			return
+		case OverflowBlock, OverflowError:
+			// messages are not sent to full mailbox with these policies
+		}
+	}
+
+	w.queue.PushBack(value)
+}
+
+func (w *mailboxWorker[T]) pushBatch(batch []T) {
+	for i, value := range batch {
+		// messages which do not fit into full mailbox are kept pending,
+		// and no other messages are accepted until they are pushed
+		if w.options.Overflow == OverflowBlock && w.isFull() {
+			w.pending = batch[i:]
+			return
+		}
+
+		w.push(value)
+	}
+}
+
+func (w *mailboxWorker[T]) pushPending() {
+	for len(w.pending) > 0 && !w.isFull() {
+		w.queue.PushBack(w.pending[0])
+		w.pending = w.pending[1:]
+	}
+
+	if len(w.pending) == 0 {
+		w.pending = nil
+	}
+}
+
+func (w *mailboxWorker[T]) drop(value T) {
+	w.stats.dropped.Add(1)
+	sendDeadLetters(w.options.DeadLetters, ErrMessageDropped, value)
+
+	if fn := w.options.OnDropFunc; fn != nil {
+		fn(value)
 	}
 }
 
@@ -309,22 +876,265 @@ func (w *mailboxWorker[T]) OnStop() {
 	// notified that no more messages will ever be received.
 	defer close(w.receiveC)
 
+	// messages sent from now on are rejected, while messages
+	// already in inbox are drained below
+	w.inbox.close()
+
 	// receiveC channel needs to receive all data before closing
-	if w.options.StopAfterReceivingAll {
-		// first: receive data from queue
+	if !w.options.StopAfterReceivingAll {
+		w.discard(nil)
+		return
+	}
+
+	for {
+		// first: move data from sendC to queue, so that
+		// all data is received in order defined by queue
+		w.drainSent()
+
+		if w.queue.IsEmpty() {
+			return
+		}
+
+		// second: receive data from queue
 		for !w.queue.IsEmpty() {
 			w.receiveC <- w.queue.PopFront()
+			w.stats.delivered.Add(1)
 		}
+	}
+}
+
+// discard sends all messages held by mailbox to dead letters, when mailbox
+// is stopped before they were received. Messages held outside of queue
+// are supplied with held, and they are discarded first.
+func (w *mailboxWorker[T]) discard(held []T) {
+	if w.options.DeadLetters == nil {
+		return
+	}
+
+	w.drainSent()
+
+	reason := fmt.Errorf("Mailbox stopped before receiving message: %w", ErrMailboxStopped)
+	sendDeadLetters(w.options.DeadLetters, reason, held...)
+
+	for !w.queue.IsEmpty() {
+		sendDeadLetters(w.options.DeadLetters, reason, w.queue.PopFront())
+	}
+}
 
-		// second: received data from sendC
-	sendCRead:
-		for {
-			select {
-			case d := <-w.sendC:
-				w.receiveC <- d
-			default:
-				break sendCRead
+// drainSent moves pending messages and messages waiting to be
+// accepted to queue, regardless of mailbox length limit.
+func (w *mailboxWorker[T]) drainSent() {
+	for _, value := range w.pending {
+		w.queue.PushBack(value)
+	}
+
+	w.pending = nil
+
+	for _, value := range w.inbox.take() {
+		w.queue.PushBack(value)
+	}
+
+	for {
+		select {
+		case value := <-w.sendC:
+			w.queue.PushBack(value)
+		case batch := <-w.sendBatchC:
+			for _, value := range batch {
+				w.queue.PushBack(value)
 			}
+		default:
+			return
+		}
+	}
+}
+
+// mbxSlots limits the number of messages held by bounded mailbox configured
+// with OverflowBlock or OverflowError policy. Senders acquire slots before
+// messages are handed to mailbox worker, and slots are released once messages
+// are received, so that senders know if mailbox is full without waiting
+// for the worker.
+type mbxSlots struct {
+	max      int64
+	used     atomic.Int64
+	waiting  atomic.Int64
+	freeLock sync.Mutex
+	freeSigC chan struct{}
+}
+
+func newMbxSlots(options optionsMailbox) *mbxSlots {
+	if options.MaxLen <= 0 {
+		return nil
+	}
+
+	if options.Overflow != OverflowBlock && options.Overflow != OverflowError {
+		return nil
+	}
+
+	return &mbxSlots{max: int64(options.MaxLen)}
+}
+
+// sendC returns channel through which messages are handed to mailbox worker.
+// Every message which has acquired slot fits into the channel, so it never
+// waits for the worker. Without slots, messages are handed over with inbox.
+func sendC[T any](s *mbxSlots) chan T {
+	if s == nil {
+		return nil
+	}
+
+	return make(chan T, s.max)
+}
+
+func (s *mbxSlots) acquire(n int) bool {
+	if s == nil {
+		return true
+	}
+
+	return s.acquireWithin(n, s.max)
+}
+
+func (s *mbxSlots) acquireWithin(n int, limit int64) bool {
+	for {
+		used := s.used.Load()
+		if used+int64(n) > limit {
+			return false
+		}
+
+		if s.used.CompareAndSwap(used, used+int64(n)) {
+			return true
+		}
+	}
+}
+
+// wait acquires n slots, waiting for them to be released while mailbox is full.
+// Batch larger than the maximum length is acquired once mailbox is empty.
+func (s *mbxSlots) wait(ctx Context, stopSigC <-chan struct{}, n int) error {
+	s.waiting.Add(1)
+	defer s.waiting.Add(-1)
+
+	limit := max(s.max, int64(n))
+
+	for {
+		// channel is obtained before acquiring, so that slots released
+		// in the meantime are not missed
+		freeSigC := s.freed()
+		if s.acquireWithin(n, limit) {
+			return nil
 		}
+
+		select {
+		case <-stopSigC:
+			return ErrMailboxStopped
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck // error is wrapped by caller
+		case <-freeSigC:
+		}
+	}
+}
+
+// freed returns channel which is closed once slots are released.
+func (s *mbxSlots) freed() <-chan struct{} {
+	s.freeLock.Lock()
+	defer s.freeLock.Unlock()
+
+	if s.freeSigC == nil {
+		s.freeSigC = make(chan struct{})
 	}
+
+	return s.freeSigC
+}
+
+func (s *mbxSlots) release(n int) {
+	if s == nil {
+		return
+	}
+
+	s.used.Add(-int64(n))
+
+	if s.waiting.Load() > 0 {
+		s.notifyFreed()
+	}
+}
+
+func (s *mbxSlots) notifyFreed() {
+	s.freeLock.Lock()
+	defer s.freeLock.Unlock()
+
+	if s.freeSigC != nil {
+		close(s.freeSigC)
+		s.freeSigC = nil
+	}
+}
+
+// mbxInbox holds messages sent to mailbox which accepts all messages, that is
+// mailbox without slots, until they are taken by mailbox worker. Senders never
+// wait for the worker, and all messages are handed over in the order they
+// were put to inbox.
+type mbxInbox[T any] struct {
+	lock   sync.Mutex
+	msgs   []T
+	closed bool
+	sigC   chan struct{}
+}
+
+func newMbxInbox[T any](slots *mbxSlots) *mbxInbox[T] {
+	if slots != nil {
+		return nil
+	}
+
+	return &mbxInbox[T]{sigC: make(chan struct{}, 1)}
+}
+
+// put adds messages to inbox and reports whether they were added,
+// which is not the case once inbox is closed.
+func (in *mbxInbox[T]) put(msgs []T) bool {
+	in.lock.Lock()
+
+	if in.closed {
+		in.lock.Unlock()
+		return false
+	}
+
+	in.msgs = append(in.msgs, msgs...)
+	in.lock.Unlock()
+
+	select {
+	case in.sigC <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+// readyC returns channel which is signaled when messages can be taken,
+// or nil when inbox is not used.
+func (in *mbxInbox[T]) readyC() <-chan struct{} {
+	if in == nil {
+		return nil
+	}
+
+	return in.sigC
+}
+
+func (in *mbxInbox[T]) take() []T {
+	if in == nil {
+		return nil
+	}
+
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	msgs := in.msgs
+	in.msgs = nil
+
+	return msgs
+}
+
+func (in *mbxInbox[T]) close() {
+	if in == nil {
+		return
+	}
+
+	in.lock.Lock()
+	in.closed = true
+	in.lock.Unlock()
 }
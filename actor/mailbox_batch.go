@@ -0,0 +1,225 @@
+package actor
+
+import "time"
+
+// BatchMailbox is a Mailbox which delivers messages in batches.
+//
+// Messages are sent one by one, or in batches, using MailboxSender, while
+// ReceiveC delivers slices of messages in the order they were sent.
+type BatchMailbox[T any] interface {
+	Actor
+	MailboxSender[T]
+	MailboxReceiver[[]T]
+}
+
+const defaultBatchSize = 64
+
+// NewBatchMailbox returns a new local BatchMailbox implementation.
+//
+// The returned Mailbox queues sent messages in the same way as the default
+// Mailbox returned by NewMailbox, with the difference that its ReceiveC
+// delivers all queued messages at once, as a single slice, instead of
+// delivering them one by one. This significantly reduces the cost of
+// receiving messages when throughput is high.
+//
+// The length of delivered slices is limited by OptBatchSize, which defaults to
+// 64 messages. By default, queued messages are delivered as soon as there is
+// a receiver, even if the batch is not full. OptBatchLinger can be used to
+// delay delivery of a batch which is not full, giving more messages time
+// to be queued.
+//
+// The returned Mailbox can be configured with the same options as the default
+// Mailbox, except OptAsChan, which is ignored. Once the Mailbox is stopped,
+// its ReceiveC is closed.
+func NewBatchMailbox[T any](opt ...MailboxOption) BatchMailbox[T] {
+	options := newOptions(opt).Mailbox
+
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultBatchSize
+	}
+
+	var (
+		slots    = newMbxSlots(options)
+		receiveC = make(chan []T)
+		worker   = newBatchMailboxWorker(receiveC, slots, options)
+	)
+
+	return &batchMailbox[T]{
+		mailboxCore: newMailboxCore(worker, worker.mailboxWorker),
+		receiveC:    receiveC,
+	}
+}
+
+type batchMailbox[T any] struct {
+	mailboxCore[T]
+	receiveC <-chan []T
+}
+
+func (m *batchMailbox[T]) ReceiveC() <-chan []T {
+	return m.receiveC
+}
+
+// batchMailboxWorker accepts messages in the same way as mailboxWorker,
+// while it delivers queued messages in batches.
+type batchMailboxWorker[T any] struct {
+	*mailboxWorker[T]
+	receiveC      chan []T
+	batch         []T
+	lingerTimer   *time.Timer
+	lingerC       <-chan time.Time
+	lingerElapsed bool
+}
+
+func newBatchMailboxWorker[T any](
+	receiveC chan []T,
+	slots *mbxSlots,
+	options optionsMailbox,
+) *batchMailboxWorker[T] {
+	w := newMailboxWorker(sendC[T](slots), nil, options)
+	w.slots = slots
+	w.inbox = newMbxInbox[T](slots)
+
+	return &batchMailboxWorker[T]{
+		mailboxWorker: w,
+		receiveC:      receiveC,
+	}
+}
+
+func (w *batchMailboxWorker[T]) DoWork(ctx Context) WorkerStatus {
+	var receiveC chan []T
+	if w.prepareBatch() {
+		receiveC = w.receiveC
+	}
+
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+
+	case receiveC <- w.batch:
+		w.onBatchReceived()
+
+	case <-w.lingerC:
+		w.lingerC = nil
+		w.lingerElapsed = true
+
+	case value := <-w.acceptC():
+		w.push(value)
+
+	case batch := <-w.acceptBatchC():
+		w.pushBatch(batch)
+
+	case <-w.inbox.readyC():
+		w.acceptInbox()
+	}
+
+	return WorkerContinue
+}
+
+// prepareBatch takes messages from queue when batch should be delivered,
+// and reports whether there is batch ready for delivery.
+func (w *batchMailboxWorker[T]) prepareBatch() bool {
+	if w.batch != nil {
+		return true
+	}
+
+	if w.queue.IsEmpty() {
+		return false
+	}
+
+	ready := w.queue.Len() >= w.options.BatchSize ||
+		w.options.BatchLinger <= 0 ||
+		w.lingerElapsed
+
+	if !ready {
+		w.startLinger()
+		return false
+	}
+
+	w.batch = make([]T, 0, min(w.queue.Len(), w.options.BatchSize))
+	for !w.queue.IsEmpty() && len(w.batch) < w.options.BatchSize {
+		w.batch = append(w.batch, w.queue.PopFront())
+	}
+
+	w.held = len(w.batch)
+
+	return true
+}
+
+func (w *batchMailboxWorker[T]) onBatchReceived() {
+	w.slots.release(len(w.batch))
+	w.stats.delivered.Add(uint64(len(w.batch)))
+	w.batch = nil
+	w.held = 0
+	w.pushPending()
+
+	// linger starts again with the next message sent to empty mailbox
+	if w.queue.IsEmpty() {
+		w.stopLinger()
+	}
+}
+
+func (w *batchMailboxWorker[T]) startLinger() {
+	if w.lingerC != nil {
+		return
+	}
+
+	if w.lingerTimer == nil {
+		w.lingerTimer = time.NewTimer(w.options.BatchLinger)
+	} else {
+		w.lingerTimer.Reset(w.options.BatchLinger)
+	}
+
+	w.lingerC = w.lingerTimer.C
+}
+
+func (w *batchMailboxWorker[T]) stopLinger() {
+	if w.lingerTimer != nil && !w.lingerTimer.Stop() {
+		// drain timer which has fired, but was not received from
+		select {
+		case <-w.lingerTimer.C:
+		default:
+		}
+	}
+
+	w.lingerC = nil
+	w.lingerElapsed = false
+}
+
+func (w *batchMailboxWorker[T]) OnStop() {
+	// close receiveC, after receiving all data,
+	// so everyone reading from this mailbox can be
+	// notified that no more messages will ever be received.
+	defer close(w.receiveC)
+
+	w.inbox.close()
+	w.stopLinger()
+
+	// receiveC channel needs to receive all data before closing
+	if !w.options.StopAfterReceivingAll {
+		w.discard(w.batch)
+		return
+	}
+
+	if w.batch != nil {
+		w.receiveC <- w.batch
+		w.stats.delivered.Add(uint64(len(w.batch)))
+		w.batch = nil
+	}
+
+	for {
+		w.drainSent()
+
+		if w.queue.IsEmpty() {
+			return
+		}
+
+		// linger is not respected while stopping
+		w.lingerElapsed = true
+
+		for w.prepareBatch() {
+			w.receiveC <- w.batch
+			w.stats.delivered.Add(uint64(len(w.batch)))
+			w.batch = nil
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package actor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that batch mailbox delivers all queued messages, in order,
+// in batches not longer than batch size.
+func Test_BatchMailbox(t *testing.T) {
+	t.Parallel()
+
+	const (
+		batchSize = 10
+		count     = 95
+	)
+
+	m := NewBatchMailbox[int](OptBatchSize(batchSize))
+	m.Start()
+
+	for i := range count {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	// Wait for all messages to be queued, so that they are delivered in full batches
+	time.Sleep(time.Millisecond * 10) //nolint:forbidigo // relax
+
+	received := make([]int, 0, count)
+	for len(received) < count {
+		batch := <-m.ReceiveC()
+		assert.NotEmpty(t, batch)
+		assert.LessOrEqual(t, len(batch), batchSize)
+
+		received = append(received, batch...)
+	}
+
+	for i := range count {
+		assert.Equal(t, i, received[i])
+	}
+
+	m.Stop()
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+	assert.ErrorIs(t, m.Send(ContextStarted(), 1), ErrMailboxStopped)
+}
+
+// Test asserts that batch mailbox delivers messages, which are sent
+// and received concurrently, without losing any of them.
+func Test_BatchMailbox_Async(t *testing.T) {
+	t.Parallel()
+
+	const count = 10000
+
+	m := NewBatchMailbox[int](OptBatchSize(16), OptMaxLen(64))
+	m.Start()
+	defer m.Stop()
+
+	go func() {
+		for i := range count {
+			assert.NoError(t, m.Send(ContextStarted(), i))
+		}
+	}()
+
+	next := 0
+	for next < count {
+		for _, v := range <-m.ReceiveC() {
+			assert.Equal(t, next, v)
+			next++
+		}
+	}
+}
+
+// Test asserts that batch mailbox delays batch which is not full
+// until linger has elapsed.
+func Test_BatchMailbox_Linger(t *testing.T) {
+	t.Parallel()
+
+	const linger = time.Millisecond * 50
+
+	m := NewBatchMailbox[int](OptBatchSize(10), OptBatchLinger(linger))
+	m.Start()
+	defer m.Stop()
+
+	sentAt := time.Now()
+
+	assert.NoError(t, m.SendBatch(ContextStarted(), []int{1, 2, 3}))
+	assert.Equal(t, []int{1, 2, 3}, <-m.ReceiveC())
+	assert.GreaterOrEqual(t, time.Since(sentAt), linger)
+
+	// Full batch should be delivered without waiting for linger
+	batch := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	sentAt = time.Now()
+
+	assert.NoError(t, m.SendBatch(ContextStarted(), batch))
+	assert.Equal(t, batch, <-m.ReceiveC())
+	assert.Less(t, time.Since(sentAt), linger)
+}
+
+// Test asserts that bounded batch mailbox counts messages of batch,
+// which is not yet received, towards its length.
+func Test_BatchMailbox_MaxLen(t *testing.T) {
+	t.Parallel()
+
+	m := NewBatchMailbox[int](
+		OptBatchSize(2),
+		OptBatchLinger(time.Hour),
+		OptMaxLen(4),
+	)
+	m.Start()
+	defer m.Stop()
+
+	for i := range 4 {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+	defer cancel()
+
+	assert.ErrorIs(t, m.Send(ctx, 4), context.DeadlineExceeded)
+
+	// Receiving batch should make space for messages of that batch
+	assert.Equal(t, []int{0, 1}, <-m.ReceiveC())
+	assert.NoError(t, m.SendBatch(ContextStarted(), []int{4, 5}))
+
+	assert.Equal(t, []int{2, 3}, <-m.ReceiveC())
+	assert.Equal(t, []int{4, 5}, <-m.ReceiveC())
+}
+
+// Test asserts that batch mailbox with OptStopAfterReceivingAll delivers
+// all queued messages before closing ReceiveC, disregarding linger.
+func Test_BatchMailbox_StopAfterReceivingAll(t *testing.T) {
+	t.Parallel()
+
+	const count = 25
+
+	m := NewBatchMailbox[int](
+		OptBatchSize(10),
+		OptBatchLinger(time.Hour),
+		OptStopAfterReceivingAll(),
+	)
+	m.Start()
+
+	for i := range count {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	go m.Stop()
+
+	received := make([]int, 0, count)
+	for batch := range m.ReceiveC() {
+		assert.LessOrEqual(t, len(batch), 10)
+
+		received = append(received, batch...)
+	}
+
+	assert.Len(t, received, count)
+
+	for i := range count {
+		assert.Equal(t, i, received[i])
+	}
+}
+
+// Test asserts that batch mailbox implements optional interfaces.
+func Test_BatchMailbox_Interfaces(t *testing.T) {
+	t.Parallel()
+
+	m := NewBatchMailbox[int]()
+	s := m.(Stateful)       //nolint:forcetypeassert // relax
+	w := m.(Waiter)         //nolint:forcetypeassert // relax
+	c := m.(ContextStopper) //nolint:forcetypeassert // relax
+	assert.Equal(t, StateNotStarted, s.State())
+
+	m.Start()
+	assert.Equal(t, StateRunning, s.State())
+	assertNotDone(t, w.Done())
+
+	assert.NoError(t, c.StopContext(ContextStarted()))
+	assertDone(t, w.Done())
+	assert.Equal(t, StateStopped, s.State())
+}
@@ -49,3 +49,29 @@ func benchmarkMailbox(b *testing.B, mbx Mailbox[any]) {
 		<-mbx.ReceiveC()
 	}
 }
+
+func BenchmarkMailboxSendBatch(b *testing.B) {
+	const batchSize = 64
+
+	mbx := NewMailbox[any]()
+	mbx.Start()
+
+	defer mbx.Stop()
+
+	batch := make([]any, batchSize)
+	for i := range batch {
+		batch[i] = `🌞`
+	}
+
+	go func() {
+		ctx := ContextStarted()
+		for sent := 0; sent < b.N; sent += batchSize {
+			//nolint:errcheck // error should never happen
+			mbx.SendBatch(ctx, batch[:min(batchSize, b.N-sent)])
+		}
+	}()
+
+	for range b.N {
+		<-mbx.ReceiveC()
+	}
+}
@@ -0,0 +1,35 @@
+package actor
+
+import "errors"
+
+// ErrMessageDropped is wrapped by the reason of DeadLetters holding messages
+// which were discarded by a Mailbox because of its OverflowPolicy.
+var ErrMessageDropped = errors.New("message dropped")
+
+// DeadLetter holds a message which could not be delivered by a Mailbox.
+type DeadLetter struct {
+	// Message is the message which could not be delivered.
+	Message any
+
+	// Reason is the error explaining why Message could not be delivered.
+	// It wraps ErrMailboxStopped, ErrMailboxFull or ErrMessageDropped, or
+	// the error of the Context which was supplied when sending Message.
+	// Routers created with Route use ErrUnhandledMessage.
+	Reason error
+}
+
+// sendDeadLetters sends msgs, wrapped with reason, to sink of dead letters,
+// when one is configured.
+func sendDeadLetters[T any](sink MailboxSender[DeadLetter], reason error, msgs ...T) {
+	if sink == nil {
+		return
+	}
+
+	for _, msg := range msgs {
+		letter := DeadLetter{Message: msg, Reason: reason}
+
+		// dead letters are best effort, if sink is unable
+		// to accept them there is nothing else that could be done
+		sink.Send(ContextStarted(), letter) //nolint:errcheck // relax
+	}
+}
@@ -0,0 +1,151 @@
+package actor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that messages, which could not be sent
+// to stopped mailbox, are sent to dead letters.
+func Test_DeadLetter_MailboxStopped(t *testing.T) {
+	t.Parallel()
+
+	sink := newDeadLetterSink(t)
+
+	for _, m := range []senderActor{
+		NewMailbox[any](OptDeadLetter(sink)),
+		NewMailbox[any](OptDeadLetter(sink), OptAsChan()),
+		NewBatchMailbox[any](OptDeadLetter(sink)),
+	} {
+		m.Start()
+		m.Stop()
+
+		assert.ErrorIs(t, m.Send(ContextStarted(), 1), ErrMailboxStopped)
+		assertDeadLetter(t, sink, 1, ErrMailboxStopped)
+
+		assert.ErrorIs(t, m.TrySend(2), ErrMailboxStopped)
+		assertDeadLetter(t, sink, 2, ErrMailboxStopped)
+
+		assert.ErrorIs(t, m.SendBatch(ContextStarted(), []any{3, 4}), ErrMailboxStopped)
+		assertDeadLetter(t, sink, 3, ErrMailboxStopped)
+		assertDeadLetter(t, sink, 4, ErrMailboxStopped)
+	}
+}
+
+// Test asserts that messages, which could not be sent
+// because context has ended, are sent to dead letters.
+func Test_DeadLetter_ContextEnded(t *testing.T) {
+	t.Parallel()
+
+	sink := newDeadLetterSink(t)
+
+	for _, m := range []Mailbox[any]{
+		NewMailbox[any](OptDeadLetter(sink), OptMaxLen(1)),
+		NewMailbox[any](OptDeadLetter(sink), OptAsChan(), OptCapacity(1)),
+	} {
+		m.Start()
+		assert.NoError(t, m.Send(ContextStarted(), 1))
+
+		assert.ErrorIs(t, m.Send(ContextEnded(), 2), ContextEnded().Err())
+		assertDeadLetter(t, sink, 2, ContextEnded().Err())
+
+		assert.ErrorIs(t, m.TrySend(3), ErrMailboxFull)
+		assertDeadLetter(t, sink, 3, ErrMailboxFull)
+
+		assert.Equal(t, 1, <-m.ReceiveC())
+		m.Stop()
+	}
+}
+
+// Test asserts that messages, discarded because of overflow,
+// are sent to dead letters.
+func Test_DeadLetter_Dropped(t *testing.T) {
+	t.Parallel()
+
+	sink := newDeadLetterSink(t)
+	m := NewMailbox[any](
+		OptDeadLetter(sink),
+		OptMaxLen(2),
+		OptOverflow(OverflowDropOldest),
+	)
+	m.Start()
+	defer m.Stop()
+
+	assert.NoError(t, m.SendBatch(ContextStarted(), []any{1, 2, 3, 4}))
+	assertDeadLetter(t, sink, 1, ErrMessageDropped)
+	assertDeadLetter(t, sink, 2, ErrMessageDropped)
+
+	assert.Equal(t, 3, <-m.ReceiveC())
+	assert.Equal(t, 4, <-m.ReceiveC())
+}
+
+// Test asserts that messages, which are discarded when mailbox
+// is stopped, are sent to dead letters in order.
+func Test_DeadLetter_Discarded(t *testing.T) {
+	t.Parallel()
+
+	sink := newDeadLetterSink(t)
+
+	for _, m := range []senderActor{
+		NewMailbox[any](OptDeadLetter(sink), OptMaxLen(10)),
+		NewBatchMailbox[any](OptDeadLetter(sink), OptMaxLen(10)),
+	} {
+		m.Start()
+
+		for i := range 10 {
+			assert.NoError(t, m.Send(ContextStarted(), i))
+		}
+
+		m.Stop()
+
+		for i := range 10 {
+			assertDeadLetter(t, sink, i, ErrMailboxStopped)
+		}
+	}
+
+	// Messages are not discarded when mailbox receives all messages
+	sink = newDeadLetterSink(t)
+	m := NewMailbox[any](OptDeadLetter(sink), OptStopAfterReceivingAll())
+	m.Start()
+
+	assert.NoError(t, m.Send(ContextStarted(), 1))
+
+	go m.Stop()
+
+	assert.Equal(t, 1, <-m.ReceiveC())
+	m.(Waiter).Wait() //nolint:forcetypeassert // relax
+
+	stats := sink.(MailboxStatsReporter).Stats() //nolint:forcetypeassert // relax
+	assert.Zero(t, stats.Sent)
+}
+
+type senderActor interface {
+	Actor
+	MailboxSender[any]
+}
+
+func newDeadLetterSink(t *testing.T) Mailbox[DeadLetter] {
+	t.Helper()
+
+	sink := NewMailbox[DeadLetter]()
+	sink.Start()
+	t.Cleanup(sink.Stop)
+
+	return sink
+}
+
+func assertDeadLetter(
+	t *testing.T,
+	sink Mailbox[DeadLetter],
+	msg any,
+	reason error,
+) {
+	t.Helper()
+
+	letter := <-sink.ReceiveC()
+	assert.Equal(t, msg, letter.Message)
+	assert.ErrorIs(t, letter.Reason, reason)
+}
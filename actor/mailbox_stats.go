@@ -0,0 +1,107 @@
+package actor
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// MailboxStats holds statistics of a Mailbox, which can be used
+// to detect receivers lagging behind senders.
+type MailboxStats struct {
+	// Len is the number of messages held by the Mailbox,
+	// which have been sent but not yet received.
+	Len int
+
+	// Cap is the maximum number of messages the Mailbox can hold
+	// before it blocks, or otherwise handles, further messages.
+	// It is zero for unbounded Mailboxes.
+	Cap int
+
+	// HighWater is the highest Len observed since the Mailbox was created.
+	HighWater int
+
+	// Sent is the total number of messages sent to the Mailbox.
+	Sent uint64
+
+	// Received is the total number of messages received from the Mailbox.
+	Received uint64
+
+	// Dropped is the total number of messages discarded by the Mailbox
+	// because of its OverflowPolicy.
+	Dropped uint64
+
+	// SendBlocked is the total time senders have spent blocked
+	// while waiting for space in the Mailbox. It remains zero for
+	// Mailboxes which accept all messages, such as unbounded ones.
+	SendBlocked time.Duration
+}
+
+// MailboxStatsReporter defines an optional interface implemented by Mailboxes
+// that can report their statistics.
+type MailboxStatsReporter interface {
+	// Stats returns the current statistics of the Mailbox.
+	//
+	// Statistics are collected while messages are sent and received
+	// without stopping the Mailbox, therefore values of the returned
+	// MailboxStats may not be mutually consistent.
+	Stats() MailboxStats
+}
+
+// mbxStats collects statistics of a Mailbox.
+//
+// Messages are counted as delivered once they are handed over to receiveC
+// of the Mailbox, while those which are still buffered by receiveC are not
+// yet received.
+type mbxStats struct {
+	sent        atomic.Uint64
+	delivered   atomic.Uint64
+	dropped     atomic.Uint64
+	highWater   atomic.Int64
+	sendBlocked atomic.Int64
+}
+
+// onSent counts n sent messages and observes length of the Mailbox,
+// which has buffered messages in receiveC.
+func (s *mbxStats) onSent(n, buffered int) {
+	s.sent.Add(uint64(n))
+	s.observeLen(s.length(buffered))
+}
+
+func (s *mbxStats) observeLen(l int) {
+	for {
+		hw := s.highWater.Load()
+		if int64(l) <= hw || s.highWater.CompareAndSwap(hw, int64(l)) {
+			return
+		}
+	}
+}
+
+// sendBlockedSince adds time elapsed since start to total time
+// senders have spent blocked.
+func (s *mbxStats) sendBlockedSince(start time.Time) {
+	s.sendBlocked.Add(int64(time.Since(start)))
+}
+
+func (s *mbxStats) length(buffered int) int {
+	// messages are counted as sent after they have been accepted,
+	// therefore sent can briefly lag behind delivered
+	dropped := s.dropped.Load()
+	delivered := s.delivered.Load()
+	sent := s.sent.Load()
+
+	return max(int(sent-delivered-dropped)+buffered, 0)
+}
+
+func (s *mbxStats) snapshot(buffered, capacity int) MailboxStats {
+	delivered := s.delivered.Load()
+
+	return MailboxStats{
+		Len:         s.length(buffered),
+		Cap:         capacity,
+		HighWater:   int(s.highWater.Load()),
+		Sent:        s.sent.Load(),
+		Received:    delivered - min(delivered, uint64(buffered)),
+		Dropped:     s.dropped.Load(),
+		SendBlocked: time.Duration(s.sendBlocked.Load()),
+	}
+}
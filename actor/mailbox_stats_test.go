@@ -0,0 +1,189 @@
+package actor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that mailboxes report length and counters of messages
+// sent and received.
+func Test_Mailbox_Stats(t *testing.T) {
+	t.Parallel()
+
+	const count = 100
+
+	for _, m := range []Mailbox[any]{
+		NewMailbox[any](),
+		NewMailbox[any](OptMaxLen(count)),
+		NewMailbox[any](OptAsChan(), OptCapacity(count)),
+		NewPriorityMailbox(func(a, b any) bool {
+			return a.(int) < b.(int) //nolint:forcetypeassert // relax
+		}),
+	} {
+		r := m.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+		assert.Zero(t, r.Stats().Len)
+		assert.Zero(t, r.Stats().Sent)
+
+		m.Start()
+
+		for i := range count {
+			assert.NoError(t, m.Send(ContextStarted(), i))
+		}
+
+		stats := r.Stats()
+		assert.Equal(t, count, stats.Len)
+		assert.Equal(t, count, stats.HighWater)
+		assert.Equal(t, uint64(count), stats.Sent)
+		assert.Equal(t, uint64(0), stats.Received)
+
+		for range count / 2 {
+			<-m.ReceiveC()
+		}
+
+		// messages are counted as received shortly after they were received
+		assertStatsEventually(t, r, func(s MailboxStats) bool {
+			return s.Received == count/2
+		})
+
+		stats = r.Stats()
+		assert.Equal(t, count/2, stats.Len)
+		assert.Equal(t, count, stats.HighWater)
+		assert.Equal(t, uint64(count), stats.Sent)
+		assert.Equal(t, uint64(count/2), stats.Received)
+		assert.Equal(t, uint64(0), stats.Dropped)
+
+		m.Stop()
+	}
+}
+
+// Test asserts that mailbox reports capacity it was configured with.
+func Test_Mailbox_Stats_Cap(t *testing.T) {
+	t.Parallel()
+
+	stats := func(m Mailbox[any]) MailboxStats {
+		return m.(MailboxStatsReporter).Stats() //nolint:forcetypeassert // relax
+	}
+
+	assert.Equal(t, 0, stats(NewMailbox[any]()).Cap)
+	assert.Equal(t, 10, stats(NewMailbox[any](OptMaxLen(10))).Cap)
+	assert.Equal(t, 10, stats(NewMailbox[any](OptAsChan(), OptCapacity(10))).Cap)
+}
+
+// Test asserts that mailbox counts messages discarded because of overflow.
+func Test_Mailbox_Stats_Dropped(t *testing.T) {
+	t.Parallel()
+
+	m := NewMailbox[any](OptMaxLen(10), OptOverflow(OverflowDropNewest))
+	r := m.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+
+	m.Start()
+	defer m.Stop()
+
+	for i := range 15 {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	// Messages are dropped once mailbox has accepted them
+	assert.Eventually(t, func() bool {
+		return r.Stats().Dropped == 5
+	}, time.Second, time.Millisecond)
+
+	stats := r.Stats()
+	assert.Equal(t, 10, stats.Len)
+	assert.Equal(t, uint64(15), stats.Sent)
+}
+
+// Test asserts that mailbox measures time senders have spent blocked.
+func Test_Mailbox_Stats_SendBlocked(t *testing.T) {
+	t.Parallel()
+
+	const blockFor = time.Millisecond * 20
+
+	for _, m := range []Mailbox[any]{
+		NewMailbox[any](OptMaxLen(1)),
+		NewMailbox[any](OptAsChan(), OptCapacity(1)),
+	} {
+		r := m.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+
+		m.Start()
+
+		assert.NoError(t, m.Send(ContextStarted(), 1))
+		assert.Less(t, r.Stats().SendBlocked, blockFor)
+
+		sentC := make(chan any)
+		go func() {
+			assert.NoError(t, m.Send(ContextStarted(), 2))
+			close(sentC)
+		}()
+
+		time.Sleep(blockFor) //nolint:forbidigo // waiting for sender to block
+		assert.Equal(t, 1, <-m.ReceiveC())
+		assert.Equal(t, 2, <-m.ReceiveC())
+		assertSignal(t, sentC)
+
+		assert.GreaterOrEqual(t, r.Stats().SendBlocked, blockFor)
+
+		m.Stop()
+	}
+
+	// Sending is never blocked while there is space in mailbox
+	for _, m := range []Mailbox[any]{
+		NewMailbox[any](),
+		NewMailbox[any](OptMaxLen(1000)),
+		NewMailbox[any](OptMaxLen(10), OptOverflow(OverflowDropOldest)),
+		NewPriorityMailbox(func(any, any) bool { return false }),
+	} {
+		r := m.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+
+		m.Start()
+
+		for i := range 1000 {
+			assert.NoError(t, m.Send(ContextStarted(), i))
+		}
+
+		assert.Zero(t, r.Stats().SendBlocked)
+
+		m.Stop()
+	}
+}
+
+// Test asserts that batch mailbox counts messages of batch as received
+// only after batch has been received.
+func Test_BatchMailbox_Stats(t *testing.T) {
+	t.Parallel()
+
+	m := NewBatchMailbox[int](OptBatchSize(5))
+	r := m.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+
+	m.Start()
+	defer m.Stop()
+
+	assert.NoError(t, m.SendBatch(ContextStarted(), []int{1, 2, 3}))
+	assert.Equal(t, 3, r.Stats().Len)
+
+	assert.Equal(t, []int{1, 2, 3}, <-m.ReceiveC())
+	assertStatsEventually(t, r, func(s MailboxStats) bool {
+		return s.Received == 3
+	})
+
+	stats := r.Stats()
+	assert.Equal(t, 0, stats.Len)
+	assert.Equal(t, uint64(3), stats.Sent)
+	assert.Equal(t, uint64(3), stats.Received)
+}
+
+func assertStatsEventually(
+	t *testing.T,
+	r MailboxStatsReporter,
+	cond func(s MailboxStats) bool,
+) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		return cond(r.Stats())
+	}, time.Second, time.Millisecond)
+}
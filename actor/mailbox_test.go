@@ -93,6 +93,25 @@ func Test_FanOut(t *testing.T) {
 	}
 }
 
+// Test asserts that Done channel of mailbox is closed when mailbox is stopped.
+func Test_Mailbox_Waiter(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range []Mailbox[any]{NewMailbox[any](), NewMailbox[any](OptAsChan())} {
+		w := m.(Waiter) //nolint:forcetypeassert // relax
+		doneC := w.Done()
+		assertNotDone(t, doneC)
+
+		m.Start()
+		assertNotDone(t, doneC)
+
+		m.Stop()
+		w.Wait()
+		assertDone(t, doneC)
+		assertMailboxStopped(t, m)
+	}
+}
+
 // Test asserts that MailboxWorker returns `WorkerEnd` when context is canceled.
 func Test_MailboxWorker_EndSignal(t *testing.T) {
 	t.Parallel()
@@ -219,6 +238,492 @@ func Test_Mailbox_OptEndAfterReceivingAll(t *testing.T) {
 	assert.Greater(t, gotMessages, initialMessagesCount)
 }
 
+// Test asserts mailbox invariants when `OptMaxLen()` option is used.
+func Test_Mailbox_MaxLen_Invariants(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []OverflowPolicy{
+		OverflowBlock, OverflowDropNewest, OverflowDropOldest, OverflowError,
+	} {
+		AssertMailboxInvariantsAsync(t, func() Mailbox[any] {
+			return NewMailbox[any](OptMaxLen(10), OptOverflow(policy))
+		})
+	}
+}
+
+// Test asserts that bounded mailbox blocks sender when it is full.
+func Test_Mailbox_MaxLen_Block(t *testing.T) {
+	t.Parallel()
+
+	const maxLen = 10
+
+	m := NewMailbox[any](OptMaxLen(maxLen))
+	m.Start()
+
+	for i := range maxLen {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	assertSendBlocking(t, m)
+
+	// Receiving message should make space for one more message
+	assert.Equal(t, 0, <-m.ReceiveC())
+	assert.NoError(t, m.Send(ContextStarted(), maxLen))
+
+	for i := 1; i <= maxLen; i++ {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	m.Stop()
+	assertMailboxStopped(t, m)
+}
+
+// Test asserts that bounded mailbox discards messages when it is full.
+func Test_Mailbox_MaxLen_Drop(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxLen  = 10
+		dropped = 5
+	)
+
+	for _, policy := range []OverflowPolicy{OverflowDropNewest, OverflowDropOldest} {
+		droppedC := make(chan any, dropped)
+		m := NewMailbox[any](
+			OptMaxLen(maxLen),
+			OptOverflow(policy),
+			OptOnDrop(func(msg any) { droppedC <- msg }),
+		)
+		m.Start()
+
+		for i := range maxLen + dropped {
+			assert.NoError(t, m.Send(ContextStarted(), i))
+		}
+
+		// Dropped messages are either newest or oldest ones
+		first := 0
+		if policy == OverflowDropNewest {
+			first = maxLen
+		}
+
+		for i := range dropped {
+			assert.Equal(t, first+i, <-droppedC)
+		}
+
+		// Remaining messages should be received in order
+		first = dropped
+		if policy == OverflowDropNewest {
+			first = 0
+		}
+
+		for i := range maxLen {
+			assert.Equal(t, first+i, <-m.ReceiveC())
+		}
+
+		m.Stop()
+		assertMailboxStopped(t, m)
+		assert.Empty(t, droppedC)
+	}
+}
+
+// Test asserts that bounded mailbox returns error when it is full.
+func Test_Mailbox_MaxLen_Error(t *testing.T) {
+	t.Parallel()
+
+	const maxLen = 10
+
+	m := NewMailbox[any](OptMaxLen(maxLen), OptOverflow(OverflowError))
+	m.Start()
+
+	for i := range maxLen {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	assert.ErrorIs(t, m.Send(ContextStarted(), maxLen), ErrMailboxFull)
+
+	// Receiving message should make space for one more message,
+	// once mailbox has accounted for received message.
+	assert.Equal(t, 0, <-m.ReceiveC())
+	assert.Eventually(t, func() bool {
+		return m.Send(ContextStarted(), maxLen) == nil
+	}, time.Second, time.Millisecond)
+
+	for i := 1; i <= maxLen; i++ {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	m.Stop()
+	assertMailboxStopped(t, m)
+}
+
+// Test asserts mailbox invariants of priority mailbox.
+func Test_PriorityMailbox_Invariants(t *testing.T) {
+	t.Parallel()
+
+	AssertMailboxInvariantsAsync(t, func() Mailbox[any] {
+		return NewPriorityMailbox(func(any, any) bool { return false })
+	})
+}
+
+// Test asserts that priority mailbox delivers messages ordered by priority.
+func Test_PriorityMailbox_Order(t *testing.T) {
+	t.Parallel()
+
+	const count = 100
+
+	m := NewPriorityMailbox(func(a, b int) bool { return a > b })
+	m.Start()
+
+	for i := range count {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	for i := count - 1; i >= 0; i-- {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	m.Stop()
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+}
+
+// Test asserts that full priority mailbox with OverflowDropOldest policy
+// discards messages with the lowest priority.
+func Test_PriorityMailbox_DropOldest(t *testing.T) {
+	t.Parallel()
+
+	const maxLen = 5
+
+	droppedC := make(chan any, maxLen)
+	m := NewPriorityMailbox(
+		func(a, b int) bool { return a > b },
+		OptMaxLen(maxLen),
+		OptOverflow(OverflowDropOldest),
+		OptOnDrop(func(msg any) { droppedC <- msg }),
+	)
+	m.Start()
+
+	for _, msg := range []int{5, 9, 2, 7, 4, 8, 1, 6} {
+		assert.NoError(t, m.Send(ContextStarted(), msg))
+	}
+
+	// Message being sent is discarded when it has the lowest priority
+	for _, msg := range []int{2, 1, 4} {
+		assert.Equal(t, msg, <-droppedC)
+	}
+
+	for _, msg := range []int{9, 8, 7, 6, 5} {
+		assert.Equal(t, msg, <-m.ReceiveC())
+	}
+
+	m.Stop()
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+	assert.Empty(t, droppedC)
+}
+
+// Test asserts that priority mailbox delivers all messages ordered by priority
+// when it is stopped with `OptStopAfterReceivingAll()` option.
+func Test_PriorityMailbox_OptStopAfterReceivingAll(t *testing.T) {
+	t.Parallel()
+
+	const count = 100
+
+	m := NewPriorityMailbox(
+		func(a, b int) bool { return a > b },
+		OptStopAfterReceivingAll(),
+	)
+	m.Start()
+
+	for i := range count {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	go m.Stop()
+
+	for i := count - 1; i >= 0; i-- {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+}
+
+// Test asserts that TrySend sends message without blocking.
+func Test_Mailbox_TrySend(t *testing.T) {
+	t.Parallel()
+
+	{ // Default mailbox accepts messages without blocking
+		m := NewMailbox[any]()
+		m.Start()
+
+		for i := range 10 {
+			assert.NoError(t, m.TrySend(i))
+		}
+
+		for i := range 10 {
+			assert.Equal(t, i, <-m.ReceiveC())
+		}
+
+		m.Stop()
+		assert.ErrorIs(t, m.TrySend(`🌹`), ErrMailboxStopped)
+	}
+
+	{ // Bounded mailbox does not accept messages when it is full
+		m := NewMailbox[any](OptMaxLen(1))
+		m.Start()
+
+		assert.NoError(t, m.Send(ContextStarted(), `🌹`))
+		assert.ErrorIs(t, m.TrySend(`🌹`), ErrMailboxFull)
+
+		m.Stop()
+	}
+
+	{ // Mailbox with OptAsChan accepts messages while there is buffer capacity
+		m := NewMailbox[any](OptAsChan(), OptCapacity(1))
+		m.Start()
+
+		assert.NoError(t, m.TrySend(`🌹`))
+		assert.ErrorIs(t, m.TrySend(`🌹`), ErrMailboxFull)
+		assert.Equal(t, `🌹`, <-m.ReceiveC())
+
+		m.Stop()
+		assert.ErrorIs(t, m.TrySend(`🌹`), ErrMailboxStopped)
+		assertMailboxStopped(t, m)
+	}
+}
+
+// Test asserts that TrySend reports full mailbox only when
+// it holds the maximum number of messages.
+func Test_Mailbox_TrySend_Full(t *testing.T) {
+	t.Parallel()
+
+	const (
+		count  = 10000
+		maxLen = 100
+	)
+
+	less := func(a, b int) bool { return a < b }
+
+	{ // Unbounded mailboxes never report full
+		for _, m := range []Mailbox[int]{
+			NewMailbox[int](),
+			NewPriorityMailbox(less),
+		} {
+			m.Start()
+
+			for i := range count {
+				assert.NoError(t, m.TrySend(i))
+			}
+
+			for i := range count {
+				assert.Equal(t, i, <-m.ReceiveC())
+			}
+
+			m.Stop()
+		}
+	}
+
+	{ // Bounded mailboxes report full once they hold maxLen messages
+		for _, m := range []Mailbox[int]{
+			NewMailbox[int](OptMaxLen(maxLen)),
+			NewMailbox[int](OptMaxLen(maxLen), OptOverflow(OverflowError)),
+			NewPriorityMailbox(less, OptMaxLen(maxLen)),
+		} {
+			m.Start()
+
+			for i := range maxLen {
+				assert.NoError(t, m.TrySend(i))
+			}
+
+			assert.ErrorIs(t, m.TrySend(maxLen), ErrMailboxFull)
+
+			// Receiving message should make space for one more message,
+			// once mailbox has accounted for received message.
+			assert.Equal(t, 0, <-m.ReceiveC())
+			assert.Eventually(t, func() bool {
+				return m.TrySend(maxLen) == nil
+			}, time.Second, time.Millisecond)
+
+			for i := 1; i <= maxLen; i++ {
+				assert.Equal(t, i, <-m.ReceiveC())
+			}
+
+			m.Stop()
+		}
+	}
+
+	{ // Concurrent senders should not exceed maxLen
+		m := NewMailbox[int](OptMaxLen(maxLen))
+		m.Start()
+
+		sent := atomic.Int64{}
+		wg := sync.WaitGroup{}
+
+		for range 4 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for i := range maxLen {
+					if m.TrySend(i) == nil {
+						sent.Add(1)
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, int64(maxLen), sent.Load())
+
+		stats := m.(MailboxStatsReporter).Stats() //nolint:forcetypeassert // relax
+		assert.Equal(t, maxLen, stats.Len)
+
+		m.Stop()
+	}
+}
+
+// Test asserts that TrySend never waits for mailbox,
+// even when mailbox has not been started.
+func Test_Mailbox_TrySend_NotStarted(t *testing.T) {
+	t.Parallel()
+
+	const maxLen = 10
+
+	less := func(a, b int) bool { return a < b }
+
+	for _, m := range []Mailbox[int]{
+		NewMailbox[int](),
+		NewMailbox[int](OptMaxLen(maxLen)),
+		NewMailbox[int](OptMaxLen(maxLen), OptOverflow(OverflowDropNewest)),
+		NewPriorityMailbox(less),
+		NewPriorityMailbox(less, OptMaxLen(maxLen), OptOverflow(OverflowDropOldest)),
+	} {
+		for i := range maxLen {
+			assert.NoError(t, m.TrySend(i))
+		}
+
+		m.Start()
+
+		for i := range maxLen {
+			assert.Equal(t, i, <-m.ReceiveC())
+		}
+
+		m.Stop()
+	}
+}
+
+// Test asserts that SendBatch sends all messages in order.
+func Test_Mailbox_SendBatch(t *testing.T) {
+	t.Parallel()
+
+	const count = 1000
+
+	for _, m := range []Mailbox[any]{
+		NewMailbox[any](),
+		NewMailbox[any](OptMaxLen(count)),
+		NewMailbox[any](OptAsChan(), OptCapacity(count)),
+		NewPriorityMailbox(func(any, any) bool { return false }),
+	} {
+		m.Start()
+
+		batch := make([]any, count)
+		for i := range batch {
+			batch[i] = i
+		}
+
+		assert.NoError(t, m.SendBatch(ContextStarted(), batch))
+		assert.NoError(t, m.SendBatch(ContextStarted(), nil))
+
+		// Modifying batch should not affect sent messages
+		for i := range batch {
+			batch[i] = nil
+		}
+
+		for i := range count {
+			assert.Equal(t, i, <-m.ReceiveC())
+		}
+
+		m.Stop()
+		assert.ErrorIs(t, m.SendBatch(ContextStarted(), batch), ErrMailboxStopped)
+		assertMailboxStopped(t, m)
+	}
+}
+
+// Test asserts that SendBatch returns error when batch could not be sent.
+func Test_Mailbox_SendBatch_Error(t *testing.T) {
+	t.Parallel()
+
+	batch := []any{`🌹`, `🌹`, `🌹`}
+
+	{ // Sending to mailbox which is not started
+		m := NewMailbox[any]()
+		assert.ErrorIs(t, m.SendBatch(ContextEnded(), batch), ErrStopped)
+	}
+
+	{ // Sending batch larger than mailbox with OverflowError policy
+		m := NewMailbox[any](OptMaxLen(2), OptOverflow(OverflowError))
+		m.Start()
+
+		assert.ErrorIs(t, m.SendBatch(ContextStarted(), batch), ErrMailboxFull)
+		assert.NoError(t, m.SendBatch(ContextStarted(), batch[:2]))
+		assert.ErrorIs(t, m.Send(ContextStarted(), `🌹`), ErrMailboxFull)
+
+		m.Stop()
+	}
+
+	{ // Sending to mailbox with OptAsChan
+		m := NewMailbox[any](OptAsChan())
+		m.Start()
+
+		assert.ErrorIs(t, m.SendBatch(ContextEnded(), batch), ErrStopped)
+
+		m.Stop()
+		assert.ErrorIs(t, m.SendBatch(ContextStarted(), batch), ErrMailboxStopped)
+	}
+}
+
+// Test asserts that batch larger than bounded mailbox is accepted at once,
+// and that other messages are blocked until batch fits into mailbox.
+func Test_Mailbox_SendBatch_MaxLen(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxLen = 5
+		count  = 20
+	)
+
+	m := NewMailbox[any](OptMaxLen(maxLen), OptStopAfterReceivingAll())
+	m.Start()
+
+	batch := make([]any, count)
+	for i := range batch {
+		batch[i] = i
+	}
+
+	assert.NoError(t, m.SendBatch(ContextStarted(), batch))
+	assertSendBlocking(t, m)
+
+	for i := range count {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	// Stopping mailbox should receive pending messages
+	assert.NoError(t, m.SendBatch(ContextStarted(), batch))
+
+	go m.Stop()
+
+	for i := range count {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+}
+
 // Test asserts mailbox invariants when `OptAsChan()` option is used.
 func Test_Mailbox_AsChan(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,98 @@
+package actor
+
+import "errors"
+
+// MapMailbox returns a Mailbox which holds messages received from in,
+// transformed with fn. The returned Mailbox is created with the supplied
+// options.
+//
+// The returned Mailbox is an Actor, which receives messages from in, and
+// which has to be started in order to transform them. It ends once in is
+// closed, after which its receive channel is closed as well. When stopped,
+// messages are no longer received from in, and the Mailbox is stopped
+// according to its options, therefore OptStopAfterReceivingAll makes the
+// Mailbox deliver all messages it holds before closing its receive channel.
+//
+// Messages can be sent directly to the returned Mailbox as well.
+func MapMailbox[A, B any](
+	in MailboxReceiver[A],
+	fn func(msg A) B,
+	opt ...MailboxOption,
+) Mailbox[B] {
+	return newTransformMailbox(in, func(ctx Context, out Mailbox[B], msg A) error {
+		return out.Send(ctx, fn(msg))
+	}, opt)
+}
+
+// FilterMailbox returns a Mailbox which holds messages received from in,
+// for which keep returns true. Other messages are discarded.
+//
+// The returned Mailbox behaves as the one returned by MapMailbox.
+func FilterMailbox[T any](
+	in MailboxReceiver[T],
+	keep func(msg T) bool,
+	opt ...MailboxOption,
+) Mailbox[T] {
+	return newTransformMailbox(in, func(ctx Context, out Mailbox[T], msg T) error {
+		if !keep(msg) {
+			return nil
+		}
+
+		return out.Send(ctx, msg)
+	}, opt)
+}
+
+// FlatMapMailbox returns a Mailbox which holds messages returned by fn for every
+// message received from in. Messages returned for a single message are sent
+// to the Mailbox with SendBatch.
+//
+// The returned Mailbox behaves as the one returned by MapMailbox.
+func FlatMapMailbox[A, B any](
+	in MailboxReceiver[A],
+	fn func(msg A) []B,
+	opt ...MailboxOption,
+) Mailbox[B] {
+	return newTransformMailbox(in, func(ctx Context, out Mailbox[B], msg A) error {
+		return out.SendBatch(ctx, fn(msg))
+	}, opt)
+}
+
+func newTransformMailbox[A, B any](
+	in MailboxReceiver[A],
+	forward func(ctx Context, out Mailbox[B], msg A) error,
+	opt []MailboxOption,
+) Mailbox[B] {
+	out := NewMailbox[B](opt...)
+
+	w := NewReceiverWorker(in, func(ctx Context, msg A) WorkerStatus {
+		// other errors are reported to dead letters of out
+		if err := forward(ctx, out, msg); errors.Is(err, ErrMailboxStopped) {
+			return WorkerEnd
+		}
+
+		return WorkerContinue
+	})
+
+	// forwarding is stopped first, so that out is stopped only
+	// after all messages it should deliver were sent to it
+	a := newCombinedActor(
+		[]Actor{New(w), out},
+		optionsCombined{StopTogether: true},
+	)
+
+	return &transformMailbox[B]{
+		combinedActor: a,
+		MailboxSender: out,
+		out:           out,
+	}
+}
+
+type transformMailbox[T any] struct {
+	*combinedActor
+	MailboxSender[T]
+	out Mailbox[T]
+}
+
+func (m *transformMailbox[T]) ReceiveC() <-chan T {
+	return m.out.ReceiveC()
+}
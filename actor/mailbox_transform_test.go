@@ -0,0 +1,111 @@
+package actor_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that mailbox transformations deliver transformed messages.
+func Test_MailboxTransform(t *testing.T) {
+	t.Parallel()
+
+	in := NewMailbox[int]()
+	mapped := MapMailbox(in, strconv.Itoa)
+	filtered := FilterMailbox(mapped, func(s string) bool { return len(s) == 1 })
+	flat := FlatMapMailbox(filtered, func(s string) []string { return []string{s, s} })
+
+	a := Combine(in, mapped, filtered, flat).Build()
+
+	a.Start()
+	defer a.Stop()
+
+	for i := range 20 {
+		assert.NoError(t, in.Send(ContextStarted(), i))
+	}
+
+	for i := range 10 {
+		assert.Equal(t, strconv.Itoa(i), <-flat.ReceiveC())
+		assert.Equal(t, strconv.Itoa(i), <-flat.ReceiveC())
+	}
+
+	// Messages can be sent directly as well
+	assert.NoError(t, flat.Send(ContextStarted(), "direct"))
+	assert.Equal(t, "direct", <-flat.ReceiveC())
+}
+
+// Test asserts that mailbox transformation is stopped, after all messages
+// are delivered, once its input is closed.
+func Test_MailboxTransform_InputClosed(t *testing.T) {
+	t.Parallel()
+
+	in := NewMailbox[int](OptStopAfterReceivingAll())
+	m := MapMailbox(in, func(i int) int { return i * 2 }, OptStopAfterReceivingAll())
+
+	in.Start()
+	m.Start()
+
+	for i := range 100 {
+		assert.NoError(t, in.Send(ContextStarted(), i))
+	}
+
+	in.Stop()
+
+	for i := range 100 {
+		assert.Equal(t, i*2, <-m.ReceiveC())
+	}
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+	assertDone(t, m.(Waiter).Done()) //nolint:forcetypeassert // relax
+	assert.ErrorIs(t, m.Send(ContextStarted(), 1), ErrMailboxStopped)
+}
+
+// Test asserts that combined actor is notified when
+// mailbox transformation ends, once its input is closed.
+func Test_MailboxTransform_InputClosedCombined(t *testing.T) {
+	t.Parallel()
+
+	in := NewMailbox[int]()
+	m := MapMailbox(in, func(i int) int { return i })
+	a := Combine(m).Build()
+
+	in.Start()
+	a.Start()
+
+	in.Stop()
+	assertDone(t, a.(Waiter).Done()) //nolint:forcetypeassert // relax
+}
+
+// Test asserts that stopping mailbox transformation
+// closes its receive channel.
+func Test_MailboxTransform_Stop(t *testing.T) {
+	t.Parallel()
+
+	in := NewMailbox[int]()
+	m := FilterMailbox(in, func(int) bool { return true })
+
+	in.Start()
+	defer in.Stop()
+
+	m.Start()
+
+	assert.NoError(t, in.Send(ContextStarted(), 1))
+	assert.Equal(t, 1, <-m.ReceiveC())
+
+	m.Stop()
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+}
+
+func Test_MailboxTransform_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return MapMailbox(NewMailbox[any](), func(msg any) any { return msg })
+	})
+}
@@ -0,0 +1,203 @@
+package actor
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Merger is an Actor which forwards messages from multiple MailboxReceivers
+// to its own receive channel.
+type Merger[T any] interface {
+	Actor
+	MailboxReceiver[T]
+}
+
+// Merge returns a builder that creates a Merger, which forwards messages
+// received from the specified MailboxReceivers.
+//
+// Messages are received from inputs fairly, in round-robin order, unless
+// weights are set with Weights. Messages from the same input are forwarded
+// in the order they were received. When all inputs are closed, the Merger
+// closes its receive channel and ends.
+//
+// When the Merger is stopped, its receive channel is closed, and a message
+// which was received but not yet forwarded is discarded. When the Merger is
+// started again, it forwards messages to a new receive channel, therefore
+// ReceiveC should be called again after restart. The Merger can be combined
+// with its inputs with Combine, so that they are started and stopped together.
+func Merge[T any](receivers ...MailboxReceiver[T]) *MergeBuilder[T] {
+	return &MergeBuilder[T]{
+		receivers: receivers,
+	}
+}
+
+type MergeBuilder[T any] struct {
+	receivers []MailboxReceiver[T]
+	weights   []int
+	options   options
+}
+
+// Weights sets the weight of every input, in the order inputs were supplied
+// to Merge. When messages are available on multiple inputs, the number of
+// messages received from each input is proportional to its weight.
+//
+// Inputs without weight, and inputs with weight lower than 1, have weight 1.
+func (b *MergeBuilder[T]) Weights(weights ...int) *MergeBuilder[T] {
+	b.weights = weights
+	return b
+}
+
+// WithOptions adds configuration options for the Merger.
+func (b *MergeBuilder[T]) WithOptions(opt ...Option) *MergeBuilder[T] {
+	b.options = newOptions(opt)
+	return b
+}
+
+// Build returns the Merger created by the MergeBuilder.
+func (b *MergeBuilder[T]) Build() Merger[T] {
+	w := &mergeWorker[T]{
+		inputs:  make([]<-chan T, len(b.receivers)),
+		weights: make([]int, len(b.receivers)),
+		current: make([]int, len(b.receivers)),
+		cases:   make([]reflect.SelectCase, len(b.receivers)+1),
+		outC:    make(chan T),
+	}
+
+	w.cases[0].Dir = reflect.SelectRecv
+
+	for i, r := range b.receivers {
+		w.inputs[i] = r.ReceiveC()
+		w.weights[i] = 1
+		w.cases[i+1] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(w.inputs[i]),
+		}
+
+		if i < len(b.weights) && b.weights[i] > 1 {
+			w.weights[i] = b.weights[i]
+		}
+	}
+
+	return &merger[T]{
+		actor:  newActor(w, b.options.Actor),
+		worker: w,
+	}
+}
+
+type merger[T any] struct {
+	*actor
+	worker *mergeWorker[T]
+}
+
+func (m *merger[T]) Start() {
+	m.actor.start(m.worker.reopen)
+}
+
+func (m *merger[T]) ReceiveC() <-chan T {
+	m.worker.outLock.Lock()
+	defer m.worker.outLock.Unlock()
+
+	return m.worker.outC
+}
+
+// mergeWorker forwards messages to outC, which is replaced
+// while the worker is not running, holding outLock.
+type mergeWorker[T any] struct {
+	inputs  []<-chan T
+	weights []int
+	current []int
+	cases   []reflect.SelectCase
+	outC    chan T
+	outLock sync.Mutex
+	closed  bool
+}
+
+// reopen replaces receive channel, which was closed when Merger has stopped,
+// so that Merger forwards messages to new channel when it is restarted.
+func (w *mergeWorker[T]) reopen() {
+	if !w.closed {
+		return
+	}
+
+	w.outLock.Lock()
+	w.outC = make(chan T)
+	w.closed = false
+	w.outLock.Unlock()
+}
+
+func (w *mergeWorker[T]) DoWork(ctx Context) WorkerStatus {
+	i := w.next()
+	if i == -1 {
+		return WorkerEnd
+	}
+
+	// message is received from selected input if it is available,
+	// otherwise from any input which becomes available first
+	select {
+	case msg, ok := <-w.inputs[i]:
+		return w.forward(ctx, i, msg, ok)
+	default:
+	}
+
+	w.cases[0].Chan = reflect.ValueOf(ctx.Done())
+
+	chosen, value, ok := reflect.Select(w.cases)
+	if chosen == 0 {
+		return WorkerEnd
+	}
+
+	var msg T
+	if ok {
+		msg, _ = value.Interface().(T)
+	}
+
+	return w.forward(ctx, chosen-1, msg, ok)
+}
+
+func (w *mergeWorker[T]) OnStop() {
+	if !w.closed {
+		w.closed = true
+		close(w.outC)
+	}
+}
+
+// next selects input using smooth weighted round-robin algorithm,
+// or returns -1 when all inputs are closed.
+func (w *mergeWorker[T]) next() int {
+	selected, total := -1, 0
+
+	for i, in := range w.inputs {
+		if in == nil {
+			continue
+		}
+
+		w.current[i] += w.weights[i]
+		total += w.weights[i]
+
+		if selected == -1 || w.current[i] > w.current[selected] {
+			selected = i
+		}
+	}
+
+	if selected != -1 {
+		w.current[selected] -= total
+	}
+
+	return selected
+}
+
+func (w *mergeWorker[T]) forward(ctx Context, i int, msg T, ok bool) WorkerStatus {
+	if !ok {
+		w.inputs[i] = nil
+		w.cases[i+1].Chan = reflect.ValueOf((<-chan T)(nil))
+
+		return WorkerContinue
+	}
+
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+	case w.outC <- msg:
+		return WorkerContinue
+	}
+}
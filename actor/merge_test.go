@@ -0,0 +1,157 @@
+package actor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+type chanReceiver[T any] chan T
+
+func (c chanReceiver[T]) ReceiveC() <-chan T {
+	return c
+}
+
+// Test asserts that merger forwards all messages from all inputs,
+// in the order they were sent to each input.
+func Test_Merge(t *testing.T) {
+	t.Parallel()
+
+	const (
+		inputsCount       = 3
+		sendMessagesCount = 100
+	)
+
+	inputs := NewMailboxes[int](inputsCount)
+	receivers := make([]MailboxReceiver[int], inputsCount)
+
+	for i, m := range inputs {
+		receivers[i] = m
+	}
+
+	m := Merge(receivers...).Build()
+	a := Combine(FromMailboxes(inputs), m).Build()
+
+	a.Start()
+	defer a.Stop()
+
+	for i, in := range inputs {
+		go func() {
+			for j := range sendMessagesCount {
+				assert.NoError(t, in.Send(ContextStarted(), i*sendMessagesCount+j))
+			}
+		}()
+	}
+
+	next := make([]int, inputsCount)
+
+	for range inputsCount * sendMessagesCount {
+		msg := <-m.ReceiveC()
+		i := msg / sendMessagesCount
+
+		assert.Equal(t, i*sendMessagesCount+next[i], msg)
+		next[i]++
+	}
+}
+
+// Test asserts that merger receives messages from inputs proportionally
+// to their weights, and that it ends when all inputs are closed.
+func Test_Merge_Weights(t *testing.T) {
+	t.Parallel()
+
+	// All inputs are holding messages, before they are closed
+	inputs := make([]MailboxReceiver[int], 3)
+
+	for i := range inputs {
+		in := make(chanReceiver[int], 100)
+		for range 100 {
+			in <- i + 1
+		}
+
+		close(in)
+		inputs[i] = in
+	}
+
+	m := Merge(inputs...).Weights(3, 1).Build()
+
+	m.Start()
+	defer m.Stop()
+
+	counts := make(map[int]int)
+	for range 50 {
+		counts[<-m.ReceiveC()]++
+	}
+
+	assert.Equal(t, map[int]int{1: 30, 2: 10, 3: 10}, counts)
+
+	// Remaining messages are forwarded before merger ends
+	for range 250 {
+		<-m.ReceiveC()
+	}
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+	assertDone(t, m.(Waiter).Done()) //nolint:forcetypeassert // relax
+}
+
+// Test asserts that stopping merger closes its receive channel,
+// and that merger forwards messages again when it is restarted.
+func Test_Merge_Stop(t *testing.T) {
+	t.Parallel()
+
+	in := make(chanReceiver[int], 1)
+	m := Merge[int](in).Build()
+
+	m.Start()
+
+	in <- 1
+	assert.Equal(t, 1, <-m.ReceiveC())
+
+	m.Stop()
+
+	_, ok := <-m.ReceiveC()
+	assert.False(t, ok)
+
+	// Restarted merger forwards messages to new receive channel
+	m.Start()
+	assert.Equal(t, StateRunning, m.(Stateful).State()) //nolint:forcetypeassert // relax
+
+	in <- 2
+	assert.Equal(t, 2, <-m.ReceiveC())
+
+	// Merger ends once all inputs are closed
+	close(in)
+
+	_, ok = <-m.ReceiveC()
+	assert.False(t, ok)
+	assertDone(t, m.(Waiter).Done()) //nolint:forcetypeassert // relax
+}
+
+// Test asserts that merger, which is combined with its inputs,
+// makes combined actor end once all inputs are closed.
+func Test_Merge_Combined(t *testing.T) {
+	t.Parallel()
+
+	in := NewMailbox[int]()
+	m := Merge[int](in).Build()
+	a := Combine(in, m).WithOptions(OptStopTogether()).Build()
+
+	a.Start()
+
+	assert.NoError(t, in.Send(ContextStarted(), 1))
+	assert.Equal(t, 1, <-m.ReceiveC())
+
+	in.Stop()
+	assertDone(t, a.(Waiter).Done())                    //nolint:forcetypeassert // relax
+	assert.Equal(t, StateStopped, a.(Stateful).State()) //nolint:forcetypeassert // relax
+}
+
+func Test_Merge_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return Merge[any](NewMailbox[any](), NewMailbox[any]()).Build()
+	})
+}
@@ -1,5 +1,10 @@
 package actor
 
+import (
+	gocontext "context"
+	"time"
+)
+
 // OptOnStart adds a function to the Actor that will be executed
 // before the first iteration of the Worker.
 //
@@ -31,11 +36,60 @@ func OptOnStart(f func(Context)) Option {
 //     specified function will be called after the corresponding
 //     method from this interface has been invoked.
 func OptOnStop(f func()) Option {
+	return func(o *options) {
+		o.Actor.OnStopFunc = func(error) { f() }
+	}
+}
+
+// OptOnStopWithReason adds a function to the Actor that will be executed
+// after the last iteration of the Worker, receiving the reason why the
+// Actor has terminated.
+//
+// This option behaves the same as OptOnStop, with the addition of the
+// termination reason being supplied to the function. See Reasoner for
+// a list of possible reasons.
+func OptOnStopWithReason(f func(reason error)) Option {
 	return func(o *options) {
 		o.Actor.OnStopFunc = f
 	}
 }
 
+// OptRecover makes the Actor recover from panics raised by its Worker.
+//
+// When this option is used, panics raised in DoWork, OnStart and OnStop
+// (including functions supplied with OptOnStart and OptOnStop) are recovered.
+// The panic value and stack trace are reported to fn, which may be nil,
+// after which the Actor proceeds as specified by policy.
+//
+// OnStop, along with the function supplied with OptOnStop, is always called
+// after a recovered panic, ensuring that cleanup is performed.
+//
+// Note: This option is applicable only to Actors created with New.
+func OptRecover(policy RecoverPolicy, fn func(value any, stack []byte)) Option {
+	return func(o *options) {
+		o.Actor.Recover = policy
+		o.Actor.OnPanicFunc = fn
+	}
+}
+
+// OptContext sets the parent context of the Actor.
+//
+// The Context supplied to the Worker inherits values and deadline from
+// the parent context. When the parent context ends, the Context of the Actor
+// ends as well, with Context.Err returning the error of the parent context,
+// which makes the Worker terminate. Stopping the Actor by calling Stop() ends
+// its Context with ErrStopped, as usual, without affecting the parent context.
+//
+// If the parent context has already ended when the Actor is started,
+// the Worker is not executed.
+//
+// Note: This option is applicable only to Actors created with New.
+func OptContext(parent gocontext.Context) Option {
+	return func(o *options) {
+		o.Actor.Context = parent
+	}
+}
+
 // OptCapacity sets the queue capacity for the Mailbox.
 //
 // This option allows you to specify the initial capacity of the
@@ -56,6 +110,82 @@ func OptCapacity(capacity int) MailboxOption {
 	}
 }
 
+// OptMaxLen limits the number of messages held by the Mailbox.
+//
+// When the Mailbox holds maxLen messages, which have been sent but not yet
+// received, subsequent messages are handled according to the policy supplied
+// with OptOverflow. By default, senders are blocked until there is space
+// in the Mailbox. Supplying zero or a negative value leaves the Mailbox unbounded.
+//
+// Note: This option is not applicable to Mailboxes using OptAsChan,
+// where OptCapacity limits the number of messages instead.
+func OptMaxLen(maxLen int) MailboxOption {
+	return func(o *options) {
+		o.Mailbox.MaxLen = maxLen
+	}
+}
+
+// OptOverflow sets the OverflowPolicy of the Mailbox, which defines
+// how messages are handled once the Mailbox has reached the length
+// limited with OptMaxLen.
+func OptOverflow(policy OverflowPolicy) MailboxOption {
+	return func(o *options) {
+		o.Mailbox.Overflow = policy
+	}
+}
+
+// OptOnDrop adds a function to the Mailbox that will be executed
+// for every message discarded by OverflowDropNewest or OverflowDropOldest
+// policy.
+//
+// The provided function is executed within the Mailbox's goroutine,
+// therefore it should not block.
+func OptOnDrop(f func(msg any)) MailboxOption {
+	return func(o *options) {
+		o.Mailbox.OnDropFunc = f
+	}
+}
+
+// OptDeadLetter attaches sink of dead letters to the Mailbox, which receives
+// every message that could not be delivered by the Mailbox, wrapped in
+// DeadLetter together with the reason.
+//
+// Messages are sent to sink when sending them to the Mailbox has failed,
+// when they are discarded because of the OverflowPolicy, and when they are
+// discarded because the Mailbox was stopped without OptStopAfterReceivingAll.
+//
+// Dead letters are sent with Send, from the goroutine of the sender or of
+// the Mailbox, therefore sink should accept messages without blocking,
+// for example it could be an unbounded Mailbox returned by NewMailbox.
+// Sink should not be the Mailbox itself.
+func OptDeadLetter(sink MailboxSender[DeadLetter]) MailboxOption {
+	return func(o *options) {
+		o.Mailbox.DeadLetters = sink
+	}
+}
+
+// OptBatchSize limits the number of messages delivered in a single batch
+// by the Mailbox returned from NewBatchMailbox.
+//
+// Supplying zero or a negative value sets the default batch size.
+func OptBatchSize(size int) MailboxOption {
+	return func(o *options) {
+		o.Mailbox.BatchSize = size
+	}
+}
+
+// OptBatchLinger sets the longest duration for which the Mailbox returned
+// from NewBatchMailbox delays delivery of a batch which is not full.
+//
+// The linger starts when the first message is queued, and the batch is
+// delivered once it is full or once the linger has elapsed, whichever
+// comes first. By default batches are delivered without delay.
+func OptBatchLinger(d time.Duration) MailboxOption {
+	return func(o *options) {
+		o.Mailbox.BatchLinger = d
+	}
+}
+
 // OptAsChan transforms the Mailbox into a wrapper for a native Go channel.
 //
 // When this option is applied, the Mailbox will behave like a
@@ -110,7 +240,22 @@ func OptOnStartCombined(f func(Context)) CombinedOption {
 // all Actors in the combination is complete. This is useful
 // for performing any necessary resource cleanup, logging, or
 // final state updates after the Actors have ceased execution.
+// The combined Actor is reported as stopped, and Waiter.Done channel
+// is closed, only after the function has returned.
 func OptOnStopCombined(f func()) CombinedOption {
+	return func(o *options) {
+		o.Combined.OnStopFunc = func(error) { f() }
+	}
+}
+
+// OptOnStopCombinedWithReason registers a function to be executed after
+// all combined Actors have been stopped, receiving the reason why the
+// combined Actor has terminated.
+//
+// The reason is the termination reason of the first combined Actor that
+// has terminated on its own, or ErrStopped if the combined Actor was stopped
+// by calling Stop() before that.
+func OptOnStopCombinedWithReason(f func(reason error)) CombinedOption {
 	return func(o *options) {
 		o.Combined.OnStopFunc = f
 	}
@@ -144,29 +289,242 @@ func OptStopParallel() CombinedOption {
 	}
 }
 
+// OptRestartStrategy sets the RestartStrategy used by a supervisor
+// to restart its children.
+//
+// When this option is not supplied, the OneForOne strategy is used.
+func OptRestartStrategy(s RestartStrategy) SupervisorOption {
+	return func(o *options) {
+		o.Supervisor.Strategy = s
+	}
+}
+
+// OptMaxRestarts limits how many restarts a supervisor may perform
+// within the given time window.
+//
+// When the limit is exceeded, the supervisor stops all of its children and
+// ends itself. Setting count to zero makes the supervisor end as soon as
+// any child terminates. Window which is not positive is replaced with
+// the default window of 5 seconds, while count is kept as supplied. When this
+// option is not supplied, a supervisor allows up to 3 restarts within 5 seconds.
+func OptMaxRestarts(count int, window time.Duration) SupervisorOption {
+	if window <= 0 {
+		window = defaultRestartWindow
+	}
+
+	return func(o *options) {
+		o.Supervisor.MaxRestarts = count
+		o.Supervisor.RestartWindow = window
+	}
+}
+
+// OptClock sets the Clock used by Actors which depend on time.
+//
+// When this option is not supplied, the Clock returned by SystemClock is used.
+// Supplying a different Clock allows time to be controlled in tests.
+func OptClock(c Clock) TimerOption {
+	return func(o *options) {
+		o.Timer.Clock = c
+	}
+}
+
+// OptJitter delays each tick of a ticker by a random duration
+// in the range [0, jitter).
+//
+// Jitter helps spreading periodic work of many Actors, which were started
+// at the same time, so that their ticks do not happen simultaneously.
+func OptJitter(jitter time.Duration) TimerOption {
+	return func(o *options) {
+		o.Timer.Jitter = jitter
+	}
+}
+
+// OptFixedDelay makes a ticker measure interval from the time the last tick
+// was handled, instead of ticking at a fixed rate.
+//
+// By default, ticks happen at a fixed rate, regardless of how long it takes
+// to handle them. With this option, the delay between the end of handling
+// one tick and the next tick is fixed instead.
+func OptFixedDelay() TimerOption {
+	return func(o *options) {
+		o.Timer.FixedDelay = true
+	}
+}
+
+// OptPoolSize sets the minimal and maximal number of workers
+// of an AutoscalingPool.
+//
+// When this option is not supplied, the pool runs at least one worker and
+// at most as many workers as reported by runtime.GOMAXPROCS.
+func OptPoolSize(minSize, maxSize int) AutoscaleOption {
+	return func(o *options) {
+		o.Autoscale.MinSize = minSize
+		o.Autoscale.MaxSize = maxSize
+	}
+}
+
+// OptScaleUpLen makes an AutoscalingPool start another worker when its
+// Mailbox holds more than n messages.
+func OptScaleUpLen(n int) AutoscaleOption {
+	return func(o *options) {
+		o.Autoscale.ScaleUpLen = n
+	}
+}
+
+// OptScaleUpWait makes an AutoscalingPool start another worker when
+// messages wait in its Mailbox longer than d.
+//
+// Wait time is estimated from the number of messages held by the Mailbox
+// and the rate at which messages have been received since the last check.
+func OptScaleUpWait(d time.Duration) AutoscaleOption {
+	return func(o *options) {
+		o.Autoscale.ScaleUpWait = d
+	}
+}
+
+// OptScaleDownCooldown sets for how long the Mailbox of an AutoscalingPool
+// has to be empty before a worker is stopped. Further workers are stopped
+// after every subsequent cooldown.
+//
+// When this option is not supplied, cooldown of 10 seconds is used.
+func OptScaleDownCooldown(d time.Duration) AutoscaleOption {
+	return func(o *options) {
+		o.Autoscale.Cooldown = d
+	}
+}
+
+// OptScaleInterval sets how often an AutoscalingPool checks its load.
+// Load is checked on ticks of a ticker, configured with the supplied options.
+//
+// When this option is not supplied, load is checked every 100 milliseconds.
+func OptScaleInterval(interval time.Duration, opt ...TimerOption) AutoscaleOption {
+	return func(o *options) {
+		o.Autoscale.Interval = interval
+		o.Autoscale.Timer = newOptions(opt).Timer
+	}
+}
+
+// OptOnScale adds a function to an AutoscalingPool that will be executed
+// whenever the number of its workers changes, receiving the number of workers
+// before and after the change. The function is called with the number of
+// workers started when the pool starts, and with zero when the pool stops.
+//
+// The provided function is executed within the goroutine which scales
+// the pool, therefore it should not block.
+func OptOnScale(f func(from, to int)) AutoscaleOption {
+	return func(o *options) {
+		o.Autoscale.OnScaleFunc = f
+	}
+}
+
+// OptSendConcurrently makes the Actor returned from NewFanOut send every
+// message to all senders concurrently.
+func OptSendConcurrently() FanOutOption {
+	return func(o *options) {
+		o.FanOut.Concurrent = true
+	}
+}
+
+// OptSendTimeout limits how long the Actor returned from NewFanOut waits
+// for a single sender to accept a message. When the timeout elapses, the
+// message is not sent to that sender.
+func OptSendTimeout(timeout time.Duration) FanOutOption {
+	return func(o *options) {
+		o.FanOut.SendTimeout = timeout
+	}
+}
+
+// OptOnSendError adds a function to the Actor returned from NewFanOut that
+// will be executed whenever sending a message fails, receiving the index of
+// the sender, the message and the error returned by the sender.
+//
+// The provided function may be executed concurrently
+// when OptSendConcurrently is used.
+func OptOnSendError(f func(sender int, msg any, err error)) FanOutOption {
+	return func(o *options) {
+		o.FanOut.OnSendErrorFunc = f
+	}
+}
+
+// OptParallelism sets the number of messages which a pipeline Stage
+// transforms concurrently.
+//
+// When this option is not supplied, messages are transformed one at a time.
+func OptParallelism(n int) StageOption {
+	return func(o *options) {
+		o.Stage.Parallelism = n
+	}
+}
+
+// OptOrdered makes a pipeline Stage, which transforms messages concurrently,
+// deliver results in the order messages were received.
+//
+// By default, results are delivered in the order transformations have finished.
+func OptOrdered() StageOption {
+	return func(o *options) {
+		o.Stage.Ordered = true
+	}
+}
+
+// OptOnStageError adds a function to a pipeline Stage that will be executed
+// for every message which could not be transformed or delivered, receiving
+// the message and the error.
+//
+// The provided function is executed within goroutines of Stage workers,
+// possibly concurrently, therefore it should not block.
+func OptOnStageError(f func(msg any, err error)) StageOption {
+	return func(o *options) {
+		o.Stage.OnErrorFunc = f
+	}
+}
+
+// OptStageMailbox sets options of the Mailbox which holds results
+// of a pipeline Stage.
+//
+// The Mailbox is always created with OptStopAfterReceivingAll, so that
+// results are not lost when the pipeline is stopped.
+func OptStageMailbox(opt ...MailboxOption) StageOption {
+	return func(o *options) {
+		o.Stage.Mailbox = opt
+	}
+}
+
 type (
 	option func(o *options)
 
-	Option         option
-	MailboxOption  option
-	CombinedOption option
+	Option           option
+	MailboxOption    option
+	CombinedOption   option
+	SupervisorOption option
+	TimerOption      option
+	AutoscaleOption  option
+	FanOutOption     option
+	StageOption      option
 )
 
 type options struct {
-	Actor    optionsActor
-	Combined optionsCombined
-	Mailbox  optionsMailbox
+	Actor      optionsActor
+	Combined   optionsCombined
+	Mailbox    optionsMailbox
+	Supervisor optionsSupervisor
+	Timer      optionsTimer
+	Autoscale  optionsAutoscale
+	FanOut     optionsFanOut
+	Stage      optionsStage
 }
 
 type optionsActor struct {
 	OnStartFunc func(Context)
-	OnStopFunc  func()
+	OnStopFunc  func(error)
+	Recover     RecoverPolicy
+	OnPanicFunc func(any, []byte)
+	Context     gocontext.Context //nolint:containedctx // parent context of actor
 }
 
 type optionsCombined struct {
 	StopTogether bool
 	StopParallel bool
-	OnStopFunc   func()
+	OnStopFunc   func(error)
 	OnStartFunc  func(Context)
 }
 
@@ -174,6 +532,48 @@ type optionsMailbox struct {
 	AsChan                bool
 	Capacity              int
 	StopAfterReceivingAll bool
+	MaxLen                int
+	Overflow              OverflowPolicy
+	OnDropFunc            func(any)
+	BatchSize             int
+	BatchLinger           time.Duration
+	DeadLetters           MailboxSender[DeadLetter]
+}
+
+type optionsSupervisor struct {
+	Strategy      RestartStrategy
+	MaxRestarts   int
+	RestartWindow time.Duration
+}
+
+type optionsTimer struct {
+	Clock      Clock
+	Jitter     time.Duration
+	FixedDelay bool
+}
+
+type optionsAutoscale struct {
+	MinSize     int
+	MaxSize     int
+	ScaleUpLen  int
+	ScaleUpWait time.Duration
+	Cooldown    time.Duration
+	Interval    time.Duration
+	Timer       optionsTimer
+	OnScaleFunc func(from, to int)
+}
+
+type optionsFanOut struct {
+	Concurrent      bool
+	SendTimeout     time.Duration
+	OnSendErrorFunc func(sender int, msg any, err error)
+}
+
+type optionsStage struct {
+	Parallelism int
+	Ordered     bool
+	OnErrorFunc func(msg any, err error)
+	Mailbox     []MailboxOption
 }
 
 func newOptions[T ~func(o *options)](opts []T) options {
@@ -1,7 +1,9 @@
 package actor_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -17,6 +19,11 @@ func TestOptions(t *testing.T) {
 	testActorOptions(t)
 	testMailboxOptions(t)
 	testCombinedOptions(t)
+	testSupervisorOptions(t)
+	testTimerOptions(t)
+	testAutoscaleOptions(t)
+	testFanOutOptions(t)
+	testStageOptions(t)
 }
 
 func testActorOptions(t *testing.T) {
@@ -40,6 +47,33 @@ func testActorOptions(t *testing.T) {
 		assert.Empty(t, opts.Combined)
 	}
 
+	{ // Assert that OptOnStopWithReason will be set
+		opts := NewOptions(OptOnStopWithReason(func(error) {}))
+		assert.NotNil(t, opts.Actor.OnStopFunc)
+		assert.Nil(t, opts.Actor.OnStartFunc)
+
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+	}
+
+	{ // Assert that OptContext will be set
+		parent := context.Background()
+		opts := NewOptions(OptContext(parent))
+		assert.Equal(t, parent, opts.Actor.Context)
+
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+	}
+
+	{ // Assert that OptRecover will be set
+		opts := NewOptions(OptRecover(RecoverRestart, func(any, []byte) {}))
+		assert.Equal(t, RecoverRestart, opts.Actor.Recover)
+		assert.NotNil(t, opts.Actor.OnPanicFunc)
+
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+	}
+
 	{ // Assert that OnStartFunc and OnStopFunc will be set
 		opts := NewOptions(OptOnStart(func(Context) {}), OptOnStop(func() {}))
 		assert.NotNil(t, opts.Actor.OnStartFunc)
@@ -61,6 +95,38 @@ func testMailboxOptions(t *testing.T) {
 		assert.Empty(t, opts.Combined)
 	}
 
+	{ // Assert that OptMaxLen, OptOverflow and OptOnDrop will be set
+		opts := NewOptions(
+			OptMaxLen(16),
+			OptOverflow(OverflowDropOldest),
+			OptOnDrop(func(any) {}),
+		)
+		assert.Equal(t, 16, opts.Mailbox.MaxLen)
+		assert.Equal(t, OverflowDropOldest, opts.Mailbox.Overflow)
+		assert.NotNil(t, opts.Mailbox.OnDropFunc)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Combined)
+	}
+
+	{ // Assert that OptDeadLetter will be set
+		sink := NewMailbox[DeadLetter]()
+		opts := NewOptions(OptDeadLetter(sink))
+		assert.Equal(t, sink, opts.Mailbox.DeadLetters)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Combined)
+	}
+
+	{ // Assert that OptBatchSize and OptBatchLinger will be set
+		opts := NewOptions(OptBatchSize(16), OptBatchLinger(time.Second))
+		assert.Equal(t, 16, opts.Mailbox.BatchSize)
+		assert.Equal(t, time.Second, opts.Mailbox.BatchLinger)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Combined)
+	}
+
 	{ // Assert that OptAsChan will be set
 		opts := NewOptions(OptAsChan())
 		assert.True(t, opts.Mailbox.AsChan)
@@ -104,4 +170,134 @@ func testCombinedOptions(t *testing.T) {
 		assert.Empty(t, opts.Actor)
 		assert.Empty(t, opts.Mailbox)
 	}
+
+	{ // Assert that OptOnStopCombinedWithReason will be set
+		opts := NewOptions(OptOnStopCombinedWithReason(func(error) {}))
+		assert.NotNil(t, opts.Combined.OnStopFunc)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+	}
+}
+
+func testSupervisorOptions(t *testing.T) {
+	t.Helper()
+
+	{ // Assert that OptRestartStrategy will be set
+		opts := NewOptions(OptRestartStrategy(RestForOne))
+		assert.Equal(t, RestForOne, opts.Supervisor.Strategy)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+	}
+
+	{ // Assert that OptMaxRestarts will be set
+		opts := NewOptions(OptMaxRestarts(10, time.Second))
+		assert.Equal(t, 10, opts.Supervisor.MaxRestarts)
+		assert.Equal(t, time.Second, opts.Supervisor.RestartWindow)
+
+		// Window which is not positive is replaced with default window
+		opts = NewOptions(OptMaxRestarts(10, 0))
+		assert.Equal(t, 10, opts.Supervisor.MaxRestarts)
+		assert.Equal(t, 5*time.Second, opts.Supervisor.RestartWindow)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+	}
+}
+
+func testTimerOptions(t *testing.T) {
+	t.Helper()
+
+	{ // Assert that OptClock will be set
+		clock := SystemClock()
+		opts := NewOptions(OptClock(clock))
+		assert.Equal(t, clock, opts.Timer.Clock)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+		assert.Empty(t, opts.Supervisor)
+	}
+
+	{ // Assert that OptJitter and OptFixedDelay will be set
+		opts := NewOptions(OptJitter(time.Second), OptFixedDelay())
+		assert.Equal(t, time.Second, opts.Timer.Jitter)
+		assert.True(t, opts.Timer.FixedDelay)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Combined)
+		assert.Empty(t, opts.Supervisor)
+	}
+}
+
+func testAutoscaleOptions(t *testing.T) {
+	t.Helper()
+
+	{ // Assert that autoscale options will be set
+		clock := SystemClock()
+		opts := NewOptions(
+			OptPoolSize(2, 5),
+			OptScaleUpLen(10),
+			OptScaleUpWait(time.Second),
+			OptScaleDownCooldown(time.Minute),
+			OptScaleInterval(time.Millisecond, OptClock(clock)),
+			OptOnScale(func(int, int) {}),
+		)
+		assert.Equal(t, 2, opts.Autoscale.MinSize)
+		assert.Equal(t, 5, opts.Autoscale.MaxSize)
+		assert.Equal(t, 10, opts.Autoscale.ScaleUpLen)
+		assert.Equal(t, time.Second, opts.Autoscale.ScaleUpWait)
+		assert.Equal(t, time.Minute, opts.Autoscale.Cooldown)
+		assert.Equal(t, time.Millisecond, opts.Autoscale.Interval)
+		assert.Equal(t, clock, opts.Autoscale.Timer.Clock)
+		assert.NotNil(t, opts.Autoscale.OnScaleFunc)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Timer)
+	}
+}
+
+func testFanOutOptions(t *testing.T) {
+	t.Helper()
+
+	{ // Assert that fan out options will be set
+		opts := NewOptions(
+			OptSendConcurrently(),
+			OptSendTimeout(time.Second),
+			OptOnSendError(func(int, any, error) {}),
+		)
+		assert.True(t, opts.FanOut.Concurrent)
+		assert.Equal(t, time.Second, opts.FanOut.SendTimeout)
+		assert.NotNil(t, opts.FanOut.OnSendErrorFunc)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.Autoscale)
+	}
+}
+
+func testStageOptions(t *testing.T) {
+	t.Helper()
+
+	{ // Assert that stage options will be set
+		opts := NewOptions(
+			OptParallelism(4),
+			OptOrdered(),
+			OptOnStageError(func(any, error) {}),
+			OptStageMailbox(OptMaxLen(10)),
+		)
+		assert.Equal(t, 4, opts.Stage.Parallelism)
+		assert.True(t, opts.Stage.Ordered)
+		assert.NotNil(t, opts.Stage.OnErrorFunc)
+		assert.Len(t, opts.Stage.Mailbox, 1)
+
+		assert.Empty(t, opts.Actor)
+		assert.Empty(t, opts.Mailbox)
+		assert.Empty(t, opts.FanOut)
+	}
 }
@@ -0,0 +1,258 @@
+package actor
+
+import "slices"
+
+// Pipeline is an Actor which processes messages sent to it by a chain
+// of stages, and which delivers results of the last stage to its
+// receive channel.
+type Pipeline[In, Out any] interface {
+	Actor
+	MailboxSender[In]
+	MailboxReceiver[Out]
+}
+
+// Stage is a step of a Pipeline, which transforms messages of type In
+// to messages of type Out.
+type Stage[In, Out any] struct {
+	fn      func(Context, In) (Out, error)
+	options optionsStage
+}
+
+// NewStage returns a Stage which transforms messages with fn.
+//
+// Every Stage runs workers, created with New, which receive messages from
+// the Mailbox holding results of the previous Stage, and which transform them
+// concurrently. By default, a Stage runs a single worker, and results are
+// delivered in the order messages were received. OptParallelism sets the number
+// of workers, in which case results are delivered as soon as they are
+// available, unless OptOrdered is used.
+//
+// The Context supplied to fn is the Context of the worker, which ends once
+// the Pipeline is stopped, so that fn can cancel long running transformations.
+// Messages which are delivered to the Stage while the Pipeline is stopping are
+// transformed with the ended Context as well. Stopping the Pipeline waits for
+// fn to return for all of these messages.
+//
+// Messages for which fn returns an error are not delivered to the next stage.
+// Instead, the message and the error are reported to the function supplied
+// with OptOnStageError.
+func NewStage[In, Out any](
+	fn func(ctx Context, msg In) (Out, error),
+	opt ...StageOption,
+) Stage[In, Out] {
+	options := newOptions(opt).Stage
+	options.Parallelism = max(options.Parallelism, 1)
+
+	return Stage[In, Out]{
+		fn:      fn,
+		options: options,
+	}
+}
+
+// connect creates workers of the stage, which receive messages from in,
+// and the Mailbox holding results of the stage.
+func (s Stage[In, Out]) connect(in Mailbox[In]) (Actor, Mailbox[Out]) {
+	mbxOpt := append(slices.Clone(s.options.Mailbox), OptStopAfterReceivingAll())
+	out := NewMailbox[Out](mbxOpt...)
+	input := &stageInput[In]{receiveC: in.ReceiveC()}
+
+	if s.options.Ordered && s.options.Parallelism > 1 {
+		input.turnC = make(chan struct{}, 1)
+		input.lastDoneC = closedSigC
+	}
+
+	actors := []Actor{in}
+	for range s.options.Parallelism {
+		actors = append(actors, New(&stageWorker[In, Out]{
+			input:   input,
+			fn:      s.fn,
+			options: s.options,
+			out:     out,
+		}))
+	}
+
+	// workers are stopped together with in, so that they
+	// transform messages it delivers while it is stopping
+	return newCombinedActor(actors, optionsCombined{StopParallel: true}), out
+}
+
+// NewPipeline returns a builder that creates a Pipeline, which starts
+// with the specified stage. Further stages are added with Then.
+//
+// Messages sent to the Pipeline are held by its Mailbox, created with the
+// supplied options, from which they are received by the first stage. Results
+// of every stage are held by a Mailbox, from which they are received by the
+// next stage. Results of the last stage are received from the Pipeline.
+func NewPipeline[In, Out any](
+	stage Stage[In, Out],
+	opt ...MailboxOption,
+) *PipelineBuilder[In, Out] {
+	return &PipelineBuilder[In, Out]{
+		mbxOpt: opt,
+		connect: func(in Mailbox[In]) ([]Actor, Mailbox[Out]) {
+			a, out := stage.connect(in)
+			return []Actor{a}, out
+		},
+	}
+}
+
+type PipelineBuilder[In, Out any] struct {
+	mbxOpt  []MailboxOption
+	connect func(in Mailbox[In]) ([]Actor, Mailbox[Out])
+}
+
+// Then returns a builder that creates a Pipeline, which consists of all stages
+// of the Pipeline created by b, followed by the specified stage.
+func Then[In, Mid, Out any](
+	b *PipelineBuilder[In, Mid],
+	stage Stage[Mid, Out],
+) *PipelineBuilder[In, Out] {
+	return &PipelineBuilder[In, Out]{
+		mbxOpt: b.mbxOpt,
+		connect: func(in Mailbox[In]) ([]Actor, Mailbox[Out]) {
+			actors, mid := b.connect(in)
+			a, out := stage.connect(mid)
+
+			return append(actors, a), out
+		},
+	}
+}
+
+// Build returns the Pipeline created by the PipelineBuilder.
+//
+// All Actors and Mailboxes of the Pipeline are combined, so that the Pipeline
+// is started and stopped as a single Actor. When the Pipeline is stopped,
+// messages which were sent to it are processed by all stages before
+// the receive channel of the Pipeline is closed, therefore stopping waits for
+// results to be received from the Pipeline. Mailboxes are stopped one at
+// a time, in the order of stages, together with workers of the Stage which
+// receives messages from them.
+//
+// Returned Pipeline implements Waiter and Stateful interfaces.
+func (b *PipelineBuilder[In, Out]) Build() Pipeline[In, Out] {
+	mbxOpt := append(slices.Clone(b.mbxOpt), OptStopAfterReceivingAll())
+	in := NewMailbox[In](mbxOpt...)
+	actors, out := b.connect(in)
+
+	return &pipeline[In, Out]{
+		combinedActor: newCombinedActor(append(actors, out), optionsCombined{}),
+		MailboxSender: in,
+		out:           out,
+	}
+}
+
+type pipeline[In, Out any] struct {
+	*combinedActor
+	MailboxSender[In]
+	out Mailbox[Out]
+}
+
+func (p *pipeline[In, Out]) ReceiveC() <-chan Out {
+	return p.out.ReceiveC()
+}
+
+// stageInput is shared by workers of a stage. When results of the stage
+// are ordered, messages are received one at a time, and each of them is
+// assigned channels which order delivery of results.
+type stageInput[In any] struct {
+	receiveC  <-chan In
+	turnC     chan struct{}
+	lastDoneC chan struct{}
+}
+
+// stageJob is a message received by a worker. Result of the message
+// is delivered once prevC is closed, after which doneC is closed.
+type stageJob[In any] struct {
+	msg   In
+	prevC chan struct{}
+	doneC chan struct{}
+}
+
+func (in *stageInput[In]) receive(ctx Context) (stageJob[In], bool) {
+	if in.turnC == nil {
+		select {
+		case <-ctx.Done():
+			return stageJob[In]{}, false
+		case msg, ok := <-in.receiveC:
+			return stageJob[In]{msg: msg}, ok
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return stageJob[In]{}, false
+	case in.turnC <- struct{}{}:
+	}
+
+	defer func() { <-in.turnC }()
+
+	select {
+	case <-ctx.Done():
+		return stageJob[In]{}, false
+	case msg, ok := <-in.receiveC:
+		if !ok {
+			return stageJob[In]{}, false
+		}
+
+		job := stageJob[In]{msg: msg, prevC: in.lastDoneC, doneC: make(chan struct{})}
+		in.lastDoneC = job.doneC
+
+		return job, true
+	}
+}
+
+// stageWorker transforms messages received from the input of the stage,
+// and sends results to the Mailbox of the stage.
+type stageWorker[In, Out any] struct {
+	input   *stageInput[In]
+	fn      func(Context, In) (Out, error)
+	options optionsStage
+	out     Mailbox[Out]
+	ctx     Context
+}
+
+func (w *stageWorker[In, Out]) OnStart(ctx Context) {
+	w.ctx = ctx
+}
+
+func (w *stageWorker[In, Out]) DoWork(ctx Context) WorkerStatus {
+	job, ok := w.input.receive(ctx)
+	if !ok {
+		return WorkerEnd
+	}
+
+	w.process(ctx, job)
+
+	return WorkerContinue
+}
+
+// OnStop transforms messages which are delivered by the input of the stage
+// while it is stopping, until its receive channel is closed.
+func (w *stageWorker[In, Out]) OnStop() {
+	for {
+		job, ok := w.input.receive(ContextStarted())
+		if !ok {
+			return
+		}
+
+		w.process(w.ctx, job)
+	}
+}
+
+func (w *stageWorker[In, Out]) process(ctx Context, job stageJob[In]) {
+	out, err := w.fn(ctx, job.msg)
+
+	if job.doneC != nil {
+		<-job.prevC
+		defer close(job.doneC)
+	}
+
+	// results are delivered even when worker is stopping
+	if err == nil {
+		err = w.out.Send(ContextStarted(), out)
+	}
+
+	if err != nil && w.options.OnErrorFunc != nil {
+		w.options.OnErrorFunc(job.msg, err)
+	}
+}
@@ -0,0 +1,181 @@
+package actor_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+func double(_ Context, i int) (int, error) {
+	return i * 2, nil
+}
+
+func itoa(_ Context, i int) (string, error) {
+	return strconv.Itoa(i), nil
+}
+
+func atoi(_ Context, s string) (int, error) {
+	return strconv.Atoi(s)
+}
+
+// Test asserts that pipeline processes messages by all stages,
+// in the order they were sent.
+func Test_Pipeline(t *testing.T) {
+	t.Parallel()
+
+	b := NewPipeline(NewStage(atoi))
+	b2 := Then(b, NewStage(double, OptParallelism(4), OptOrdered()))
+	p := Then(b2, NewStage(itoa)).Build()
+
+	p.Start()
+	defer p.Stop()
+
+	for i := range 100 {
+		assert.NoError(t, p.Send(ContextStarted(), strconv.Itoa(i)))
+	}
+
+	for i := range 100 {
+		assert.Equal(t, strconv.Itoa(i*2), <-p.ReceiveC())
+	}
+}
+
+// Test asserts that stage without ordered output delivers results
+// as soon as they are available.
+func Test_Pipeline_Unordered(t *testing.T) {
+	t.Parallel()
+
+	releaseC := make(chan any)
+	p := NewPipeline(NewStage(func(_ Context, i int) (int, error) {
+		if i == 0 {
+			<-releaseC
+		}
+
+		return i, nil
+	}, OptParallelism(2))).Build()
+
+	p.Start()
+	defer p.Stop()
+
+	for i := range 10 {
+		assert.NoError(t, p.Send(ContextStarted(), i))
+	}
+
+	// First message is delivered last, since it is blocked until released
+	for i := 1; i < 10; i++ {
+		assert.Equal(t, i, <-p.ReceiveC())
+	}
+
+	close(releaseC)
+	assert.Equal(t, 0, <-p.ReceiveC())
+}
+
+// Test asserts that messages which could not be transformed
+// are reported and skipped.
+func Test_Pipeline_StageError(t *testing.T) {
+	t.Parallel()
+
+	errorsC := make(chan sendError, 10)
+	onError := OptOnStageError(func(msg any, err error) {
+		errorsC <- sendError{msg: msg, err: err}
+	})
+
+	p := NewPipeline(NewStage(atoi, OptParallelism(3), OptOrdered(), onError)).
+		Build()
+
+	p.Start()
+	defer p.Stop()
+
+	for _, msg := range []string{"1", "x", "2", "y", "3"} {
+		assert.NoError(t, p.Send(ContextStarted(), msg))
+	}
+
+	for i := 1; i <= 3; i++ {
+		assert.Equal(t, i, <-p.ReceiveC())
+	}
+
+	for _, msg := range []string{"x", "y"} {
+		e := <-errorsC
+		assert.Equal(t, msg, e.msg)
+		assert.ErrorIs(t, e.err, strconv.ErrSyntax)
+	}
+}
+
+// Test asserts that pipeline processes all messages, which were sent to it,
+// before it is stopped.
+func Test_Pipeline_DrainOnStop(t *testing.T) {
+	t.Parallel()
+
+	const sendMessagesCount = 200
+
+	slow := NewStage(func(_ Context, i int) (int, error) {
+		time.Sleep(time.Microsecond) //nolint:forbidigo // simulates work
+		return i, nil
+	}, OptParallelism(4), OptOrdered())
+	p := Then(NewPipeline(slow), NewStage(double, OptParallelism(2))).Build()
+
+	p.Start()
+
+	for i := range sendMessagesCount {
+		assert.NoError(t, p.Send(ContextStarted(), i))
+	}
+
+	stoppedC := make(chan any)
+
+	go func() {
+		p.Stop()
+		close(stoppedC)
+	}()
+
+	received := make(map[int]bool)
+	for msg := range p.ReceiveC() {
+		received[msg] = true
+	}
+
+	assert.Len(t, received, sendMessagesCount)
+	assertSignal(t, stoppedC)
+	assert.ErrorIs(t, p.Send(ContextStarted(), 1), ErrMailboxStopped)
+}
+
+// Test asserts that stage function receives context, which ends
+// when pipeline is stopped.
+func Test_Pipeline_StopContext(t *testing.T) {
+	t.Parallel()
+
+	startedC := make(chan any)
+	errorsC := make(chan sendError, 1)
+	onError := OptOnStageError(func(msg any, err error) {
+		errorsC <- sendError{msg: msg, err: err}
+	})
+
+	p := NewPipeline(NewStage(func(ctx Context, i int) (int, error) {
+		close(startedC)
+		<-ctx.Done()
+
+		return i, ctx.Err()
+	}, onError)).Build()
+
+	p.Start()
+	assert.NoError(t, p.Send(ContextStarted(), 1))
+	assertSignal(t, startedC)
+
+	p.Stop()
+
+	e := <-errorsC
+	assert.Equal(t, 1, e.msg)
+	assert.ErrorIs(t, e.err, ErrStopped)
+
+	_, ok := <-p.ReceiveC()
+	assert.False(t, ok)
+}
+
+func Test_Pipeline_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return NewPipeline(NewStage(double, OptParallelism(2))).Build()
+	})
+}
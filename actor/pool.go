@@ -0,0 +1,164 @@
+package actor
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"sync/atomic"
+)
+
+// Pool is an Actor which owns a number of member Actors, each with its own
+// Mailbox, and which routes messages sent to it to one of the members.
+type Pool[T any] interface {
+	Actor
+	MailboxSender[T]
+}
+
+// PoolRouting selects the member of a Pool which receives msg.
+//
+// The function is supplied with the number of members, and with lenOf
+// reporting the number of messages held by the Mailbox of a member.
+// It must return an index in the range [0, size).
+type PoolRouting[T any] func(msg T, size int, lenOf func(i int) int) int
+
+// NewPool returns a new Pool with the specified number of members.
+//
+// Each member consists of a Mailbox, created with the supplied options, and
+// of an Actor, created by fact, which receives messages from that Mailbox.
+// Messages sent to the Pool are routed to members with routing, or in
+// round-robin order when routing is nil. Mailboxes and Actors of all members
+// are combined with Combine, so that the Pool is started and stopped as
+// a single Actor; Mailboxes are stopped first.
+//
+// Messages sent with SendBatch are routed one by one, therefore some of the
+// messages could be sent even if error is returned.
+func NewPool[T any](
+	size int,
+	fact func(mbx MailboxReceiver[T]) Actor,
+	routing PoolRouting[T],
+	opt ...MailboxOption,
+) Pool[T] {
+	size = max(size, 1)
+
+	if routing == nil {
+		routing = RouteRoundRobin[T]()
+	}
+
+	mailboxes := NewMailboxes[T](size, opt...)
+	actors := make([]Actor, 0, 2*size) //nolint:mnd // mailbox and actor per member
+
+	for _, m := range mailboxes {
+		actors = append(actors, m)
+	}
+
+	for _, m := range mailboxes {
+		actors = append(actors, fact(m))
+	}
+
+	return &pool[T]{
+		combinedActor: newCombinedActor(actors, optionsCombined{}),
+		mailboxes:     mailboxes,
+		routing:       routing,
+	}
+}
+
+// RouteRoundRobin returns PoolRouting which routes messages
+// to members in turn.
+func RouteRoundRobin[T any]() PoolRouting[T] {
+	next := &atomic.Uint64{}
+
+	return func(_ T, size int, _ func(int) int) int {
+		return int((next.Add(1) - 1) % uint64(size))
+	}
+}
+
+// RouteRandom returns PoolRouting which routes messages
+// to randomly selected members.
+func RouteRandom[T any]() PoolRouting[T] {
+	return func(_ T, size int, _ func(int) int) int {
+		return rand.IntN(size) //nolint:gosec // relax
+	}
+}
+
+// RouteLeastLoaded returns PoolRouting which routes messages to the member
+// whose Mailbox holds the least messages. Among equally loaded members,
+// the one with the lowest index is selected.
+func RouteLeastLoaded[T any]() PoolRouting[T] {
+	return func(_ T, size int, lenOf func(int) int) int {
+		least, leastLen := 0, lenOf(0)
+
+		for i := 1; i < size && leastLen > 0; i++ {
+			if l := lenOf(i); l < leastLen {
+				least, leastLen = i, l
+			}
+		}
+
+		return least
+	}
+}
+
+// RouteConsistentHash returns PoolRouting which routes messages by their key,
+// so that all messages with the same key are received by the same member.
+//
+// Keys are assigned to members using consistent hashing, therefore only a small
+// portion of keys is assigned to different members when the number of members
+// changes.
+func RouteConsistentHash[T any](key func(msg T) string) PoolRouting[T] {
+	return func(msg T, size int, _ func(int) int) int {
+		h := fnv.New64a()
+		h.Write([]byte(key(msg))) //nolint:errcheck // never returns error
+
+		return jumpHash(h.Sum64(), size)
+	}
+}
+
+// jumpHash implements "A Fast, Minimal Memory, Consistent Hash Algorithm"
+// by John Lamping and Eric Veach.
+//
+//nolint:mnd // constants of the algorithm
+func jumpHash(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(1<<31) / float64((key>>33)+1)))
+	}
+
+	return int(b)
+}
+
+type pool[T any] struct {
+	*combinedActor
+	mailboxes []Mailbox[T]
+	routing   PoolRouting[T]
+}
+
+func (p *pool[T]) Send(ctx Context, msg T) error {
+	return p.member(msg).Send(ctx, msg)
+}
+
+func (p *pool[T]) TrySend(msg T) error {
+	return p.member(msg).TrySend(msg)
+}
+
+func (p *pool[T]) SendBatch(ctx Context, msgs []T) error {
+	for _, msg := range msgs {
+		if err := p.Send(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *pool[T]) member(msg T) Mailbox[T] {
+	return p.mailboxes[p.routing(msg, len(p.mailboxes), p.lenOf)]
+}
+
+func (p *pool[T]) lenOf(i int) int {
+	if r, ok := p.mailboxes[i].(MailboxStatsReporter); ok {
+		return r.Stats().Len
+	}
+
+	return 0
+}
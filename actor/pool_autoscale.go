@@ -0,0 +1,273 @@
+package actor
+
+import (
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultScaleInterval = 100 * time.Millisecond
+	defaultScaleCooldown = 10 * time.Second
+)
+
+// AutoscalingPool is an Actor which runs a varying number of workers
+// receiving messages from a shared Mailbox.
+type AutoscalingPool[T any] interface {
+	Actor
+	MailboxSender[T]
+
+	// Size returns the number of workers which are currently running.
+	Size() int
+}
+
+// NewAutoscalingPool returns a new AutoscalingPool which owns the supplied
+// Mailbox, and which runs Actors, created with New, for Workers returned
+// by fact. All workers receive messages from mbx.
+//
+// The pool starts with the minimal number of workers set by OptPoolSize.
+// Load of the pool is checked periodically, and when the Mailbox holds more
+// messages than the threshold set by OptScaleUpLen, or when estimated time
+// messages wait in the Mailbox exceeds the threshold set by OptScaleUpWait,
+// another worker is started. When neither threshold is set, a worker is started
+// whenever the Mailbox holds more messages than there are workers. Once the
+// Mailbox has been empty for the duration set by OptScaleDownCooldown,
+// a worker is stopped. At most one worker is started or stopped per check,
+// and the number of workers always stays within the configured bounds.
+// Changes of the number of workers are reported to the function supplied with
+// OptOnScale.
+//
+// Workers are expected to run until they are stopped, or until the receive
+// channel of mbx is closed, that is when the pool is stopped. The Mailbox is
+// stopped first when the pool is stopped, after which all workers are stopped.
+// Workers which end on their own are no longer counted by Size, and they are
+// removed from the pool on the next check. When fewer than the minimal number
+// of workers remain, new workers are started in their place.
+//
+// Load of the pool is measured with MailboxStats, therefore mbx should
+// implement MailboxStatsReporter. Otherwise, the number of workers never
+// exceeds the minimum.
+func NewAutoscalingPool[T any](
+	mbx Mailbox[T],
+	fact func(mbx MailboxReceiver[T]) Worker,
+	opt ...AutoscaleOption,
+) AutoscalingPool[T] {
+	options := newOptions(opt).Autoscale
+
+	options.MinSize = max(options.MinSize, 1)
+	if options.MaxSize <= 0 {
+		options.MaxSize = max(options.MinSize, runtime.GOMAXPROCS(0))
+	}
+
+	options.MaxSize = max(options.MaxSize, options.MinSize)
+
+	if options.Interval <= 0 {
+		options.Interval = defaultScaleInterval
+	}
+
+	if options.Cooldown <= 0 {
+		options.Cooldown = defaultScaleCooldown
+	}
+
+	if options.Timer.Clock == nil {
+		options.Timer.Clock = SystemClock()
+	}
+
+	s := &scaler[T]{
+		mbx:     mbx,
+		fact:    fact,
+		options: options,
+	}
+
+	scalerActor := New(
+		&tickerWorker[any]{
+			ticker: newTicker(options.Interval, options.Timer),
+			onTick: s.check,
+		},
+		OptOnStart(s.start),
+		OptOnStop(s.stopAll),
+	)
+
+	return &autoscalingPool[T]{
+		combinedActor: newCombinedActor([]Actor{mbx, scalerActor}, optionsCombined{}),
+		MailboxSender: mbx,
+		scaler:        s,
+	}
+}
+
+type autoscalingPool[T any] struct {
+	*combinedActor
+	MailboxSender[T]
+	scaler *scaler[T]
+}
+
+func (p *autoscalingPool[T]) Size() int {
+	return int(p.scaler.size.Load())
+}
+
+// scaler starts and stops workers of autoscaling pool. Size is updated by
+// workers, while all methods are called from the goroutine of the scaler Actor.
+type scaler[T any] struct {
+	mbx     Mailbox[T]
+	fact    func(MailboxReceiver[T]) Worker
+	options optionsAutoscale
+	workers []*actor
+	size    atomic.Int64
+
+	lastCheck    time.Time
+	lastReceived uint64
+	lastProgress time.Time
+	idleSince    time.Time
+}
+
+func (s *scaler[T]) start(Context) {
+	now := s.options.Timer.Clock.Now()
+	stats := s.stats()
+
+	s.lastCheck, s.lastProgress, s.idleSince = now, now, time.Time{}
+	s.lastReceived = stats.Received
+
+	s.scaleTo(s.options.MinSize)
+}
+
+func (s *scaler[T]) stopAll() {
+	s.scaleTo(0)
+}
+
+func (s *scaler[T]) check(Context) WorkerStatus {
+	s.replaceEnded()
+
+	now := s.options.Timer.Clock.Now()
+	stats := s.stats()
+	size := len(s.workers)
+
+	received := stats.Received - s.lastReceived
+	if received > 0 {
+		s.lastProgress = now
+	}
+
+	wait := s.estimateWait(now, stats.Len, received)
+	s.lastCheck, s.lastReceived = now, stats.Received
+
+	if stats.Len > 0 {
+		s.idleSince = time.Time{}
+
+		if s.overloaded(stats.Len, wait) && size < s.options.MaxSize {
+			s.scaleTo(size + 1)
+		}
+
+		return WorkerContinue
+	}
+
+	if s.idleSince.IsZero() {
+		s.idleSince = now
+	} else if now.Sub(s.idleSince) >= s.options.Cooldown && size > s.options.MinSize {
+		s.scaleTo(size - 1)
+
+		// next worker is stopped after another cooldown
+		s.idleSince = now
+	}
+
+	return WorkerContinue
+}
+
+// estimateWait estimates how long messages wait in the Mailbox, from the number
+// of messages it holds and the number of messages received since last check.
+func (s *scaler[T]) estimateWait(
+	now time.Time,
+	length int,
+	received uint64,
+) time.Duration {
+	if length == 0 {
+		return 0
+	}
+
+	if received == 0 {
+		return now.Sub(s.lastProgress)
+	}
+
+	elapsed := uint64(now.Sub(s.lastCheck)) //nolint:gosec // time is monotonic
+
+	return time.Duration(elapsed * uint64(length) / received) //nolint:gosec // relax
+}
+
+func (s *scaler[T]) overloaded(length int, wait time.Duration) bool {
+	if s.options.ScaleUpLen <= 0 && s.options.ScaleUpWait <= 0 {
+		return length > len(s.workers)
+	}
+
+	return (s.options.ScaleUpLen > 0 && length > s.options.ScaleUpLen) ||
+		(s.options.ScaleUpWait > 0 && wait > s.options.ScaleUpWait)
+}
+
+// replaceEnded removes workers which have ended on their own, and starts
+// new workers when fewer than the minimal number of workers remain, unless
+// the Mailbox is stopping, in which case workers end because of it.
+func (s *scaler[T]) replaceEnded() {
+	from := len(s.workers)
+	s.workers = slices.DeleteFunc(s.workers, func(w *actor) bool {
+		select {
+		case <-w.Done():
+			return true
+		default:
+			return false
+		}
+	})
+
+	if len(s.workers) == from {
+		return
+	}
+
+	s.onScale(from, len(s.workers))
+
+	if st, ok := s.mbx.(Stateful); ok && st.State() >= StateStopping {
+		return
+	}
+
+	if len(s.workers) < s.options.MinSize {
+		s.scaleTo(s.options.MinSize)
+	}
+}
+
+func (s *scaler[T]) scaleTo(size int) {
+	from := len(s.workers)
+	if from == size {
+		return
+	}
+
+	for len(s.workers) < size {
+		// workers are counted while they are running,
+		// so that workers which end on their own are not counted
+		s.size.Add(1)
+
+		w := newActor(s.fact(s.mbx), optionsActor{
+			OnStopFunc: func(error) { s.size.Add(-1) },
+		})
+		w.Start()
+		s.workers = append(s.workers, w)
+	}
+
+	for len(s.workers) > size {
+		last := len(s.workers) - 1
+		s.workers[last].Stop()
+		s.workers[last] = nil
+		s.workers = s.workers[:last]
+	}
+
+	s.onScale(from, size)
+}
+
+func (s *scaler[T]) onScale(from, to int) {
+	if f := s.options.OnScaleFunc; f != nil {
+		f(from, to)
+	}
+}
+
+func (s *scaler[T]) stats() MailboxStats {
+	if r, ok := s.mbx.(MailboxStatsReporter); ok {
+		return r.Stats()
+	}
+
+	return MailboxStats{}
+}
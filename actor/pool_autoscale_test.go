@@ -0,0 +1,161 @@
+package actor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+type scaleEvent struct{ from, to int }
+
+// newBlockingWorker returns pool worker factory creating workers,
+// which are handling messages only when releaseC is closed.
+func newBlockingWorker(releaseC chan any) func(MailboxReceiver[int]) Worker {
+	return func(mbx MailboxReceiver[int]) Worker {
+		return NewReceiverWorker(mbx, func(ctx Context, _ int) WorkerStatus {
+			select {
+			case <-ctx.Done():
+			case <-releaseC:
+			}
+
+			return WorkerContinue
+		})
+	}
+}
+
+// advanceUntilScaled advances clock until pool reports that it has scaled.
+func advanceUntilScaled(clock *fakeClock, scaleC <-chan scaleEvent) scaleEvent {
+	for {
+		clock.Advance(500 * time.Millisecond)
+
+		select {
+		case e := <-scaleC:
+			return e
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// Test asserts that pool starts workers when mailbox holds too many messages,
+// and that it stops workers once mailbox has been empty for cooldown.
+func Test_AutoscalingPool(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	releaseC := make(chan any)
+	scaleC := make(chan scaleEvent, 10)
+
+	m := NewMailbox[int]()
+	p := NewAutoscalingPool(m, newBlockingWorker(releaseC),
+		OptPoolSize(1, 3),
+		OptScaleUpLen(2),
+		OptScaleDownCooldown(time.Second),
+		OptScaleInterval(time.Second, OptClock(clock)),
+		OptOnScale(func(from, to int) { scaleC <- scaleEvent{from, to} }),
+	)
+
+	p.Start()
+	assert.Equal(t, scaleEvent{0, 1}, <-scaleC)
+	assert.Equal(t, 1, p.Size())
+
+	for i := range 10 {
+		assert.NoError(t, p.Send(ContextStarted(), i))
+	}
+
+	assert.Equal(t, scaleEvent{1, 2}, advanceUntilScaled(clock, scaleC))
+	assert.Equal(t, scaleEvent{2, 3}, advanceUntilScaled(clock, scaleC))
+	assert.Equal(t, 3, p.Size())
+
+	// All messages are handled once workers are released
+	close(releaseC)
+	assert.Eventually(t, func() bool {
+		return m.(MailboxStatsReporter).Stats().Len == 0 //nolint:forcetypeassert // relax
+	}, time.Second, time.Millisecond)
+
+	assert.Equal(t, scaleEvent{3, 2}, advanceUntilScaled(clock, scaleC))
+	assert.Equal(t, scaleEvent{2, 1}, advanceUntilScaled(clock, scaleC))
+	assert.Equal(t, 1, p.Size())
+
+	p.Stop()
+	assert.Equal(t, scaleEvent{1, 0}, <-scaleC)
+	assert.Equal(t, 0, p.Size())
+	assertDone(t, p.(Waiter).Done()) //nolint:forcetypeassert // relax
+}
+
+// Test asserts that pool starts workers when messages wait
+// in mailbox for too long.
+func Test_AutoscalingPool_ScaleUpWait(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	releaseC := make(chan any)
+	scaleC := make(chan scaleEvent, 10)
+
+	p := NewAutoscalingPool(NewMailbox[int](), newBlockingWorker(releaseC),
+		OptPoolSize(1, 2),
+		OptScaleUpWait(time.Second),
+		OptScaleInterval(time.Second, OptClock(clock)),
+		OptOnScale(func(from, to int) { scaleC <- scaleEvent{from, to} }),
+	)
+
+	p.Start()
+	defer p.Stop()
+
+	assert.Equal(t, scaleEvent{0, 1}, <-scaleC)
+
+	assert.NoError(t, p.Send(ContextStarted(), 1))
+	assert.NoError(t, p.Send(ContextStarted(), 2))
+
+	assert.Equal(t, scaleEvent{1, 2}, advanceUntilScaled(clock, scaleC))
+	assert.Equal(t, 2, p.Size())
+
+	close(releaseC)
+}
+
+// Test asserts that pool stops counting workers which end on their own,
+// and that it replaces them to keep the minimal number of workers.
+func Test_AutoscalingPool_WorkerEnd(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	scaleC := make(chan scaleEvent, 10)
+
+	fact := func(mbx MailboxReceiver[int]) Worker {
+		return NewReceiverWorker(mbx, func(Context, int) WorkerStatus {
+			return WorkerEnd
+		})
+	}
+
+	p := NewAutoscalingPool(NewMailbox[int](), fact,
+		OptPoolSize(2, 2),
+		OptScaleInterval(time.Second, OptClock(clock)),
+		OptOnScale(func(from, to int) { scaleC <- scaleEvent{from, to} }),
+	)
+
+	p.Start()
+	defer p.Stop()
+
+	assert.Equal(t, scaleEvent{0, 2}, <-scaleC)
+
+	// Worker which has ended is no longer counted
+	assert.NoError(t, p.Send(ContextStarted(), 1))
+	assert.Eventually(t, func() bool {
+		return p.Size() == 1
+	}, time.Second, time.Millisecond)
+
+	// Worker is removed and replaced on the next check
+	assert.Equal(t, scaleEvent{2, 1}, advanceUntilScaled(clock, scaleC))
+	assert.Equal(t, scaleEvent{1, 2}, <-scaleC)
+	assert.Equal(t, 2, p.Size())
+}
+
+func Test_AutoscalingPool_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return NewAutoscalingPool(NewMailbox[int](), newBlockingWorker(nil))
+	})
+}
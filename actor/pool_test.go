@@ -0,0 +1,189 @@
+package actor_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+type poolMsg struct {
+	member int
+	msg    int
+}
+
+// newPoolRecorder returns pool factory creating members,
+// which send received messages to handledC together with member index.
+func newPoolRecorder(handledC chan poolMsg) func(MailboxReceiver[int]) Actor {
+	member := 0
+
+	return func(mbx MailboxReceiver[int]) Actor {
+		i := member
+		member++
+
+		return New(NewReceiverWorker(mbx, func(_ Context, msg int) WorkerStatus {
+			handledC <- poolMsg{member: i, msg: msg}
+			return WorkerContinue
+		}))
+	}
+}
+
+// Test asserts that pool routes messages to its members in round-robin order.
+func Test_Pool_RoundRobin(t *testing.T) {
+	t.Parallel()
+
+	const size = 3
+
+	handledC := make(chan poolMsg, 100)
+	p := NewPool(size, newPoolRecorder(handledC), nil)
+
+	p.Start()
+
+	for i := range size * 10 {
+		assert.NoError(t, p.Send(ContextStarted(), i))
+	}
+
+	for range size * 10 {
+		h := <-handledC
+		assert.Equal(t, h.msg%size, h.member)
+	}
+
+	assert.NoError(t, p.SendBatch(ContextStarted(), []int{0, 1, 2}))
+	assert.NoError(t, p.TrySend(0))
+
+	for range 4 {
+		h := <-handledC
+		assert.Equal(t, h.msg%size, h.member)
+	}
+
+	p.Stop()
+	assertDone(t, p.(Waiter).Done()) //nolint:forcetypeassert // relax
+	assert.ErrorIs(t, p.Send(ContextStarted(), 1), ErrMailboxStopped)
+}
+
+// Test asserts that pool routes messages with the same key to the same member.
+func Test_Pool_ConsistentHash(t *testing.T) {
+	t.Parallel()
+
+	handledC := make(chan poolMsg, 100)
+	routing := RouteConsistentHash(func(msg int) string {
+		return strconv.Itoa(msg % 10)
+	})
+	p := NewPool(4, newPoolRecorder(handledC), routing)
+
+	p.Start()
+	defer p.Stop()
+
+	for i := range 100 {
+		assert.NoError(t, p.Send(ContextStarted(), i))
+	}
+
+	memberOfKey := make(map[int]int)
+
+	for range 100 {
+		h := <-handledC
+		key := h.msg % 10
+
+		if member, ok := memberOfKey[key]; ok {
+			assert.Equal(t, member, h.member)
+		} else {
+			memberOfKey[key] = h.member
+		}
+	}
+}
+
+// Test asserts that only keys assigned to new member are reassigned
+// when number of members increases.
+func Test_RouteConsistentHash(t *testing.T) {
+	t.Parallel()
+
+	routing := RouteConsistentHash(strconv.Itoa)
+	counts := make([]int, 5)
+
+	for i := range 10000 {
+		before := routing(i, 4, nil)
+		after := routing(i, 5, nil)
+
+		if before != after {
+			assert.Equal(t, 4, after)
+		}
+
+		counts[after]++
+	}
+
+	// Keys should be spread among all members
+	for _, c := range counts {
+		assert.Greater(t, c, 1500)
+	}
+}
+
+func Test_RouteLeastLoaded(t *testing.T) {
+	t.Parallel()
+
+	routing := RouteLeastLoaded[int]()
+	lens := []int{3, 1, 2, 1}
+	lenOf := func(i int) int { return lens[i] }
+
+	assert.Equal(t, 1, routing(0, len(lens), lenOf))
+
+	lens[3] = 0
+	assert.Equal(t, 3, routing(0, len(lens), lenOf))
+}
+
+// Test asserts that pool routes messages to member with the least messages.
+func Test_Pool_LeastLoaded(t *testing.T) {
+	t.Parallel()
+
+	const size = 3
+
+	// Members are not receiving messages, so mailboxes hold all messages sent
+	mailboxes := make([]MailboxStatsReporter, 0, size)
+	p := NewPool(size, func(mbx MailboxReceiver[int]) Actor {
+		r := mbx.(MailboxStatsReporter) //nolint:forcetypeassert // relax
+		mailboxes = append(mailboxes, r)
+
+		return Idle()
+	}, RouteLeastLoaded[int]())
+
+	p.Start()
+	defer p.Stop()
+
+	for i := range size * 3 {
+		assert.NoError(t, p.Send(ContextStarted(), i))
+	}
+
+	for _, m := range mailboxes {
+		assert.Equal(t, 3, m.Stats().Len)
+	}
+}
+
+func Test_RouteRandom(t *testing.T) {
+	t.Parallel()
+
+	routing := RouteRandom[int]()
+	seen := make(map[int]bool)
+
+	for range 1000 {
+		i := routing(0, 3, nil)
+		assert.GreaterOrEqual(t, i, 0)
+		assert.Less(t, i, 3)
+
+		seen[i] = true
+	}
+
+	assert.Len(t, seen, 3)
+}
+
+func Test_Pool_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return NewPool(3, func(mbx MailboxReceiver[any]) Actor {
+			return New(NewReceiverWorker(mbx, func(Context, any) WorkerStatus {
+				return WorkerContinue
+			}))
+		}, RouteRandom[any]())
+	})
+}
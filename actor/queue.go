@@ -4,6 +4,19 @@ import (
 	queueImpl "github.com/gammazero/deque"
 )
 
+// messageQueue holds messages of a mailbox which have been sent,
+// but not yet received.
+type messageQueue[T any] interface {
+	PushBack(val T)
+	Front() T
+	PopFront() T
+	// Evict removes and returns the message which is discarded
+	// when mailbox with OverflowDropOldest policy overflows.
+	Evict() T
+	Len() int
+	IsEmpty() bool
+}
+
 func newQueue[T any](capacity int) *queue[T] {
 	q := &queueImpl.Deque[T]{}
 	q.SetBaseCap(max(minQueueCapacity, capacity))
@@ -28,6 +41,11 @@ func (q *queue[T]) PopFront() T {
 	return q.q.PopFront()
 }
 
+// Evict removes the oldest message.
+func (q *queue[T]) Evict() T {
+	return q.q.PopFront()
+}
+
 func (q *queue[T]) Len() int {
 	return q.q.Len()
 }
@@ -35,3 +53,121 @@ func (q *queue[T]) Len() int {
 func (q *queue[T]) IsEmpty() bool {
 	return q.q.Len() == 0
 }
+
+// newPriorityQueue returns queue which orders messages using less function,
+// so that Front returns the message with the highest priority. Messages with
+// equal priority are ordered in the same order as they were pushed.
+func newPriorityQueue[T any](less func(a, b T) bool, capacity int) *priorityQueue[T] {
+	return &priorityQueue[T]{
+		items: make([]priorityItem[T], 0, max(minQueueCapacity, capacity)),
+		less:  less,
+	}
+}
+
+type priorityQueue[T any] struct {
+	items []priorityItem[T]
+	less  func(a, b T) bool
+	seq   uint64
+}
+
+type priorityItem[T any] struct {
+	val T
+	seq uint64
+}
+
+func (q *priorityQueue[T]) PushBack(val T) {
+	q.seq++
+	q.items = append(q.items, priorityItem[T]{val: val, seq: q.seq})
+	q.up(len(q.items) - 1)
+}
+
+func (q *priorityQueue[T]) Front() T {
+	return q.items[0].val
+}
+
+func (q *priorityQueue[T]) PopFront() T {
+	return q.remove(0)
+}
+
+// Evict removes the message with the lowest priority, which would be
+// received last. Such message is always a leaf of the heap.
+func (q *priorityQueue[T]) Evict() T {
+	lowest := len(q.items) / 2 //nolint:mnd // binary heap
+
+	for i := lowest + 1; i < len(q.items); i++ {
+		if q.before(lowest, i) {
+			lowest = i
+		}
+	}
+
+	return q.remove(lowest)
+}
+
+func (q *priorityQueue[T]) remove(i int) T {
+	last := len(q.items) - 1
+	val := q.items[i].val
+
+	q.items[i] = q.items[last]
+	q.items[last] = priorityItem[T]{} // release reference for gc
+	q.items = q.items[:last]
+
+	if i < last {
+		q.up(i)
+		q.down(i)
+	}
+
+	return val
+}
+
+func (q *priorityQueue[T]) Len() int {
+	return len(q.items)
+}
+
+func (q *priorityQueue[T]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+func (q *priorityQueue[T]) before(i, j int) bool {
+	a, b := q.items[i], q.items[j]
+
+	if q.less(a.val, b.val) {
+		return true
+	}
+
+	if q.less(b.val, a.val) {
+		return false
+	}
+
+	return a.seq < b.seq
+}
+
+func (q *priorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2 //nolint:mnd // binary heap
+		if !q.before(i, parent) {
+			return
+		}
+
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+func (q *priorityQueue[T]) down(i int) {
+	for {
+		first := i
+
+		for _, child := range []int{2*i + 1, 2*i + 2} { //nolint:mnd // binary heap
+			if child < len(q.items) && q.before(child, first) {
+				first = child
+			}
+		}
+
+		if first == i {
+			return
+		}
+
+		q.items[i], q.items[first] = q.items[first], q.items[i]
+		i = first
+	}
+}
@@ -1,6 +1,7 @@
 package actor_test
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -76,3 +77,80 @@ func TestQueue_Cap(t *testing.T) {
 		assert.Equal(t, 0, q.Len())
 	}
 }
+
+func TestPriorityQueue_Basic(t *testing.T) {
+	t.Parallel()
+
+	q := NewPriorityQueue(func(a, b int) bool { return a > b }, 0)
+
+	assert.Equal(t, 0, q.Len())
+	assert.True(t, q.IsEmpty())
+
+	for _, v := range []int{2, 5, 1, 4, 3} {
+		q.PushBack(v)
+	}
+
+	assert.Equal(t, 5, q.Len())
+	assert.False(t, q.IsEmpty())
+
+	// Values are popped in order of priority
+	for _, v := range []int{5, 4, 3, 2, 1} {
+		assert.Equal(t, v, q.Front())
+		assert.Equal(t, v, q.PopFront())
+	}
+
+	assert.Equal(t, 0, q.Len())
+	assert.True(t, q.IsEmpty())
+}
+
+func TestPriorityQueue_Order(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		priority int
+		seq      int
+	}
+
+	const count = 1000
+
+	q := NewPriorityQueue(func(a, b item) bool { return a.priority > b.priority }, 0)
+	items := make([]item, count)
+
+	for i := range items {
+		items[i] = item{priority: (i * 7919) % 10, seq: i}
+		q.PushBack(items[i])
+	}
+
+	// Items with higher priority should be popped first,
+	// while items with equal priority should keep their order
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].priority > items[j].priority
+	})
+
+	for _, it := range items {
+		assert.Equal(t, it, q.PopFront())
+	}
+
+	assert.True(t, q.IsEmpty())
+}
+
+func TestPriorityQueue_Evict(t *testing.T) {
+	t.Parallel()
+
+	q := NewPriorityQueue(func(a, b int) bool { return a > b }, 0)
+
+	for _, v := range []int{5, 1, 8, 3, 7, 2, 6, 4} {
+		q.PushBack(v)
+	}
+
+	// Values with the lowest priority are evicted first,
+	// while remaining values keep their order
+	assert.Equal(t, 1, q.Evict())
+	assert.Equal(t, 2, q.Evict())
+
+	for _, v := range []int{8, 7, 6, 5, 4, 3} {
+		assert.Equal(t, v, q.PopFront())
+	}
+
+	assert.True(t, q.IsEmpty())
+}
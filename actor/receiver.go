@@ -0,0 +1,135 @@
+package actor
+
+// NewReceiverWorker returns a Worker which calls handle for every message
+// received from mbx. The returned WorkerStatus of handle is returned by DoWork.
+//
+// The returned Worker ends when the Actor is stopped or when the receive
+// channel of mbx is closed, that is when the Mailbox is stopped.
+func NewReceiverWorker[T any](
+	mbx MailboxReceiver[T],
+	handle func(ctx Context, msg T) WorkerStatus,
+) Worker {
+	return &receiverWorker[T]{
+		receiveC: mbx.ReceiveC(),
+		handle:   handle,
+	}
+}
+
+type receiverWorker[T any] struct {
+	receiveC <-chan T
+	handle   func(Context, T) WorkerStatus
+}
+
+func (w *receiverWorker[T]) DoWork(ctx Context) WorkerStatus {
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+
+	case msg, ok := <-w.receiveC:
+		if !ok {
+			return WorkerEnd
+		}
+
+		return w.handle(ctx, msg)
+	}
+}
+
+// drainReceived handles messages which are already received,
+// without waiting for new messages.
+func (w *receiverWorker[T]) drainReceived(ctx Context) {
+	for {
+		select {
+		case msg, ok := <-w.receiveC:
+			if !ok || w.handle(ctx, msg) == WorkerEnd {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// Receiver is an Actor which handles messages sent to it.
+type Receiver[T any] interface {
+	Actor
+	MailboxSender[T]
+}
+
+// NewReceiver returns a Receiver which owns the supplied Mailbox, and which
+// handles messages received from it with handle.
+//
+// The Mailbox and the Actor handling messages, created with the supplied
+// options, are combined with Combine, so that they are started and stopped
+// together. When handle returns WorkerEnd, the Mailbox is stopped as well.
+// Messages are sent to the Receiver with the methods of MailboxSender.
+//
+// When the Receiver is stopped, its Mailbox is stopped first, after which
+// messages that the Mailbox has already delivered are handled before the
+// Receiver stops. Mailbox created with OptStopAfterReceivingAll makes the
+// Receiver handle all messages sent before it was stopped.
+//
+// Returned Receiver implements Waiter and Stateful interfaces.
+func NewReceiver[T any](
+	mbx Mailbox[T],
+	handle func(ctx Context, msg T) WorkerStatus,
+	opt ...Option,
+) Receiver[T] {
+	return newReceiver(mbx, handle, opt)
+}
+
+func newReceiver[T any](
+	mbx Mailbox[T],
+	handle func(ctx Context, msg T) WorkerStatus,
+	opt []Option,
+) *receiver[T] {
+	w := &drainingReceiverWorker[T]{
+		receiverWorker: &receiverWorker[T]{
+			receiveC: mbx.ReceiveC(),
+			handle:   handle,
+		},
+	}
+
+	a := newCombinedActor(
+		[]Actor{mbx, New(w, opt...)},
+		optionsCombined{StopTogether: true},
+	)
+
+	return &receiver[T]{
+		combinedActor: a,
+		MailboxSender: mbx,
+	}
+}
+
+type receiver[T any] struct {
+	*combinedActor
+	MailboxSender[T]
+}
+
+// drainingReceiverWorker is receiverWorker which, once stopped, handles
+// messages that are already received. Messages are handled even when Worker
+// is stopped before DoWork has been called.
+type drainingReceiverWorker[T any] struct {
+	*receiverWorker[T]
+	ctx   Context
+	ended bool
+}
+
+func (w *drainingReceiverWorker[T]) DoWork(ctx Context) WorkerStatus {
+	status := w.receiverWorker.DoWork(ctx)
+
+	// handle has ended the Worker, therefore no more messages are handled
+	w.ended = status == WorkerEnd && ctx.Err() == nil
+
+	return status
+}
+
+func (w *drainingReceiverWorker[T]) OnStart(ctx Context) {
+	w.ctx = ctx
+	w.ended = false
+}
+
+func (w *drainingReceiverWorker[T]) OnStop() {
+	if !w.ended {
+		w.drainReceived(w.ctx)
+	}
+}
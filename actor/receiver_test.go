@@ -0,0 +1,176 @@
+package actor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that receiver worker handles received messages,
+// and that it ends when mailbox is stopped.
+func Test_ReceiverWorker(t *testing.T) {
+	t.Parallel()
+
+	handledC := make(chan int, 10)
+	m := NewMailbox[int]()
+	w := NewReceiverWorker(m, func(_ Context, msg int) WorkerStatus {
+		handledC <- msg
+		return WorkerContinue
+	})
+	a := New(w)
+
+	m.Start()
+	a.Start()
+
+	for i := range 10 {
+		assert.NoError(t, m.Send(ContextStarted(), i))
+	}
+
+	for i := range 10 {
+		assert.Equal(t, i, <-handledC)
+	}
+
+	m.Stop()
+	assertDone(t, a.(Waiter).Done()) //nolint:forcetypeassert // relax
+
+	AssertWorkerEndSig(t, w)
+}
+
+// Test asserts that receiver handles messages sent to it.
+func Test_Receiver(t *testing.T) {
+	t.Parallel()
+
+	handledC := make(chan int, 10)
+	r := NewReceiver(NewMailbox[int](), func(_ Context, msg int) WorkerStatus {
+		handledC <- msg
+		return WorkerContinue
+	})
+
+	r.Start()
+
+	for i := range 10 {
+		assert.NoError(t, r.Send(ContextStarted(), i))
+	}
+
+	for i := range 10 {
+		assert.Equal(t, i, <-handledC)
+	}
+
+	r.Stop()
+	assertDone(t, r.(Waiter).Done()) //nolint:forcetypeassert // relax
+	assert.ErrorIs(t, r.Send(ContextStarted(), 1), ErrMailboxStopped)
+}
+
+// Test asserts that receiver stops its mailbox when handler ends.
+func Test_Receiver_HandlerEnded(t *testing.T) {
+	t.Parallel()
+
+	onStopC := make(chan any, 1)
+	r := NewReceiver(NewMailbox[int](),
+		func(Context, int) WorkerStatus {
+			return WorkerEnd
+		},
+		OptOnStop(func() { onStopC <- `🌚` }),
+	)
+
+	r.Start()
+	assert.NoError(t, r.Send(ContextStarted(), 1))
+
+	assertSignal(t, onStopC)
+	assertDone(t, r.(Waiter).Done()) //nolint:forcetypeassert // relax
+	assert.ErrorIs(t, r.Send(ContextStarted(), 2), ErrMailboxStopped)
+}
+
+// Test asserts that actors, which combine or supervise receiver,
+// are notified when receiver ends because its handler has ended.
+func Test_Receiver_HandlerEndedNotifies(t *testing.T) {
+	t.Parallel()
+
+	newReceiver := func() Receiver[int] {
+		return NewReceiver(NewMailbox[int](), func(Context, int) WorkerStatus {
+			return WorkerEnd
+		})
+	}
+
+	{ // Combined actor ends together with receiver
+		r := newReceiver()
+		a := Combine(r).Build()
+
+		a.Start()
+		assert.NoError(t, r.Send(ContextStarted(), 1))
+		assertDone(t, a.(Waiter).Done()) //nolint:forcetypeassert // relax
+	}
+
+	{ // Supervisor, which can not restart receiver, ends
+		r := newReceiver()
+		s := Supervise(r).WithOptions(OptMaxRestarts(0, time.Hour)).Build()
+
+		s.Start()
+		assert.NoError(t, r.Send(ContextStarted(), 1))
+		assertDone(t, s.(Waiter).Done()) //nolint:forcetypeassert // relax
+
+		reason := s.(Reasoner).Reason() //nolint:forcetypeassert // relax
+		assert.ErrorIs(t, reason, ErrMaxRestartsExceeded)
+	}
+}
+
+// Test asserts that receiver with OptStopAfterReceivingAll
+// handles all messages before it stops.
+func Test_Receiver_StopAfterReceivingAll(t *testing.T) {
+	t.Parallel()
+
+	handled := 0
+	r := NewReceiver(NewMailbox[int](OptStopAfterReceivingAll()),
+		func(Context, int) WorkerStatus {
+			handled++
+			return WorkerContinue
+		},
+	)
+
+	r.Start()
+
+	for i := range 100 {
+		assert.NoError(t, r.Send(ContextStarted(), i))
+	}
+
+	r.Stop()
+	assert.Equal(t, 100, handled)
+}
+
+// Test asserts that receiver handles all messages, even when it is stopped
+// before its worker has started handling them.
+func Test_Receiver_StopImmediately(t *testing.T) {
+	t.Parallel()
+
+	for range 100 {
+		handled := 0
+		r := NewReceiver(NewMailbox[int](OptStopAfterReceivingAll()),
+			func(Context, int) WorkerStatus {
+				handled++
+				return WorkerContinue
+			},
+		)
+
+		r.Start()
+
+		for i := range 10 {
+			assert.NoError(t, r.Send(ContextStarted(), i))
+		}
+
+		r.Stop()
+		assert.Equal(t, 10, handled)
+	}
+}
+
+func Test_Receiver_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		return NewReceiver(NewMailbox[any](), func(Context, any) WorkerStatus {
+			return WorkerContinue
+		})
+	})
+}
@@ -0,0 +1,149 @@
+package actor
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// ErrUnhandledMessage is wrapped by the reason of DeadLetters holding
+// messages for which a router has no handler.
+var ErrUnhandledMessage = errors.New("unhandled message")
+
+// Route returns a builder that creates an Actor which routes messages,
+// received from the specified Mailbox, to handlers registered per type
+// of message with Handle.
+//
+// Messages of types without a registered handler are handled by the function
+// supplied to Fallback, or sent to dead letters with FallbackToDeadLetter.
+// By default, such messages are discarded.
+//
+// The created Actor ends when the receive channel of mbx is closed, and it
+// can be combined with other Actors, including the Mailbox, with Combine.
+func Route(mbx MailboxReceiver[any]) *RouterBuilder {
+	return &RouterBuilder{
+		mbx:      mbx,
+		handlers: make(map[reflect.Type]func(Context, any)),
+	}
+}
+
+type RouterBuilder struct {
+	mbx      MailboxReceiver[any]
+	handlers map[reflect.Type]func(Context, any)
+	matchers []typeMatcher
+	fallback func(Context, any)
+	options  options
+}
+
+// typeMatcher handles messages which implement interface type t,
+// reporting whether message was handled.
+type typeMatcher struct {
+	t     reflect.Type
+	match func(Context, any) bool
+}
+
+// Handle registers handle as the handler of messages of type M,
+// for the Actor created by the RouterBuilder.
+//
+// When M is a concrete type, handle receives messages of exactly that type.
+// When M is an interface type, handle receives messages implementing it,
+// unless there is a handler registered for their concrete type. Interface
+// handlers are matched in the order they were registered.
+//
+// Registering another handler for the same type replaces the previous one.
+// Replaced interface handler keeps the position of the previous one.
+func Handle[M any](b *RouterBuilder, handle func(ctx Context, msg M)) *RouterBuilder {
+	t := reflect.TypeFor[M]()
+
+	if t.Kind() == reflect.Interface {
+		matcher := typeMatcher{t: t, match: func(ctx Context, msg any) bool {
+			m, ok := msg.(M)
+			if ok {
+				handle(ctx, m)
+			}
+
+			return ok
+		}}
+
+		i := slices.IndexFunc(b.matchers, func(m typeMatcher) bool { return m.t == t })
+		if i == -1 {
+			b.matchers = append(b.matchers, matcher)
+		} else {
+			b.matchers[i] = matcher
+		}
+
+		return b
+	}
+
+	b.handlers[t] = func(ctx Context, msg any) {
+		handle(ctx, msg.(M)) //nolint:forcetypeassert // type is matched by router
+	}
+
+	return b
+}
+
+// Fallback sets the function handling messages of types without
+// a registered handler.
+func (b *RouterBuilder) Fallback(fn func(ctx Context, msg any)) *RouterBuilder {
+	b.fallback = fn
+	return b
+}
+
+// FallbackToDeadLetter makes the router send messages of types without
+// a registered handler to sink, wrapped in DeadLetter with reason
+// wrapping ErrUnhandledMessage.
+func (b *RouterBuilder) FallbackToDeadLetter(
+	sink MailboxSender[DeadLetter],
+) *RouterBuilder {
+	return b.Fallback(func(_ Context, msg any) {
+		reason := fmt.Errorf("router has no handler for %T: %w", msg, ErrUnhandledMessage)
+		sendDeadLetters(sink, reason, msg)
+	})
+}
+
+// WithOptions adds configuration options for the router Actor.
+func (b *RouterBuilder) WithOptions(opt ...Option) *RouterBuilder {
+	b.options = newOptions(opt)
+	return b
+}
+
+// Build returns the router Actor created by the RouterBuilder.
+//
+// Handlers registered after Build has been called have no effect
+// on the returned Actor.
+func (b *RouterBuilder) Build() Actor {
+	r := &router{
+		handlers: maps.Clone(b.handlers),
+		matchers: slices.Clone(b.matchers),
+		fallback: b.fallback,
+	}
+
+	return newActor(NewReceiverWorker(b.mbx, r.route), b.options.Actor)
+}
+
+type router struct {
+	handlers map[reflect.Type]func(Context, any)
+	matchers []typeMatcher
+	fallback func(Context, any)
+}
+
+func (r *router) route(ctx Context, msg any) WorkerStatus {
+	if handle, ok := r.handlers[reflect.TypeOf(msg)]; ok {
+		handle(ctx, msg)
+		return WorkerContinue
+	}
+
+	for _, m := range r.matchers {
+		if m.match(ctx, msg) {
+			return WorkerContinue
+		}
+	}
+
+	if r.fallback != nil {
+		r.fallback(ctx, msg)
+	}
+
+	return WorkerContinue
+}
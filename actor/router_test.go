@@ -0,0 +1,137 @@
+package actor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+type (
+	routedFoo  struct{ v int }
+	routedBar  string
+	routedBaz  struct{}
+	routedUnit int
+)
+
+func (routedBaz) String() string { return "baz" }
+
+// Test asserts that router routes messages to handlers
+// registered for their type.
+func Test_Router(t *testing.T) {
+	t.Parallel()
+
+	handledC := make(chan any, 10)
+	handle := func(msg any) { handledC <- msg }
+
+	m := NewMailbox[any]()
+	b := Route(m)
+	Handle(b, func(_ Context, msg routedFoo) { handle(msg) })
+	Handle(b, func(_ Context, msg routedBar) { handle(msg) })
+	Handle(b, func(_ Context, _ fmt.Stringer) { handle("stringer") })
+	Handle(b, func(_ Context, msg *routedFoo) { handle(msg.v) })
+
+	a := Combine(m, b.Fallback(func(_ Context, msg any) {
+		handle(fmt.Sprint("fallback ", msg))
+	}).Build()).Build()
+
+	a.Start()
+	defer a.Stop()
+
+	for _, msg := range []any{
+		routedFoo{v: 1}, routedBar("bar"), routedBaz{}, &routedFoo{v: 2}, routedUnit(3), nil,
+	} {
+		assert.NoError(t, m.Send(ContextStarted(), msg))
+	}
+
+	assert.Equal(t, routedFoo{v: 1}, <-handledC)
+	assert.Equal(t, routedBar("bar"), <-handledC)
+	assert.Equal(t, "stringer", <-handledC)
+	assert.Equal(t, 2, <-handledC)
+	assert.Equal(t, "fallback 3", <-handledC)
+	assert.Equal(t, "fallback <nil>", <-handledC)
+}
+
+type routedStringer interface {
+	String() string
+}
+
+// Test asserts that registering another handler for the same type
+// replaces the previous one, keeping its position.
+func Test_Router_ReplaceHandler(t *testing.T) {
+	t.Parallel()
+
+	handledC := make(chan any, 10)
+
+	m := NewMailbox[any]()
+	b := Route(m)
+	Handle(b, func(_ Context, _ routedFoo) { handledC <- "first foo" })
+	Handle(b, func(_ Context, _ fmt.Stringer) { handledC <- "first stringer" })
+	Handle(b, func(_ Context, _ routedStringer) { handledC <- "routed stringer" })
+	Handle(b, func(_ Context, _ routedFoo) { handledC <- "second foo" })
+	Handle(b, func(_ Context, _ fmt.Stringer) { handledC <- "second stringer" })
+
+	a := Combine(m, b.Build()).Build()
+
+	a.Start()
+	defer a.Stop()
+
+	assert.NoError(t, m.Send(ContextStarted(), routedFoo{}))
+	assert.NoError(t, m.Send(ContextStarted(), routedBaz{}))
+
+	assert.Equal(t, "second foo", <-handledC)
+	assert.Equal(t, "second stringer", <-handledC)
+}
+
+// Test asserts that router sends unhandled messages to dead letters.
+func Test_Router_FallbackToDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	sink := newDeadLetterSink(t)
+	handledC := make(chan any, 10)
+
+	m := NewMailbox[any]()
+	b := Route(m).FallbackToDeadLetter(sink)
+	Handle(b, func(_ Context, msg int) { handledC <- msg })
+
+	a := Combine(m, b.Build()).Build()
+	a.Start()
+	defer a.Stop()
+
+	assert.NoError(t, m.Send(ContextStarted(), "unhandled"))
+	assert.NoError(t, m.Send(ContextStarted(), 1))
+
+	assertDeadLetter(t, sink, "unhandled", ErrUnhandledMessage)
+	assert.Equal(t, 1, <-handledC)
+}
+
+// Test asserts that messages are discarded when router has no fallback,
+// and that router ends when mailbox is stopped.
+func Test_Router_NoFallback(t *testing.T) {
+	t.Parallel()
+
+	handledC := make(chan any, 10)
+	onStopC := make(chan any, 1)
+
+	m := NewMailbox[any]()
+	b := Route(m).WithOptions(OptOnStop(func() { onStopC <- `🌚` }))
+	Handle(b, func(_ Context, msg int) { handledC <- msg })
+
+	a := b.Build()
+
+	// Handlers registered after Build should have no effect
+	Handle(b, func(_ Context, msg string) { handledC <- msg })
+
+	m.Start()
+	a.Start()
+
+	assert.NoError(t, m.Send(ContextStarted(), "unhandled"))
+	assert.NoError(t, m.Send(ContextStarted(), 1))
+	assert.Equal(t, 1, <-handledC)
+
+	m.Stop()
+	assertSignal(t, onStopC)
+	assertDone(t, a.(Waiter).Done()) //nolint:forcetypeassert // relax
+}
@@ -0,0 +1,251 @@
+package actor
+
+import (
+	"container/heap"
+	gocontext "context"
+	"sync"
+	"time"
+)
+
+// Scheduler is an Actor which executes scheduled functions, and delivers
+// scheduled messages, at their due time.
+type Scheduler interface {
+	Actor
+
+	// Schedule schedules fn to be called once d has elapsed.
+	//
+	// Scheduled functions are called one by one, in the order of their due
+	// time, from the goroutine of the Scheduler. The supplied Context ends
+	// when the Scheduler is stopped.
+	//
+	// The returned function cancels the scheduled call. It reports whether
+	// the call was canceled before fn was called.
+	Schedule(d time.Duration, fn func(ctx Context)) func() bool
+}
+
+// NewScheduler returns a new Scheduler.
+//
+// Functions can be scheduled at any time, while they are called only while
+// the Scheduler is running. Functions which have not been called before the
+// Scheduler is stopped are called once it is started again.
+//
+// Returned Scheduler implements Waiter and Stateful interfaces.
+func NewScheduler(opt ...TimerOption) Scheduler {
+	options := newOptions(opt).Timer
+
+	if options.Clock == nil {
+		options.Clock = SystemClock()
+	}
+
+	w := newSchedulerWorker(options)
+
+	return &scheduler{
+		actor:  newActor(w, optionsActor{}),
+		worker: w,
+	}
+}
+
+// SendAfter schedules msg to be sent to mbx, using the supplied Scheduler,
+// once d has elapsed.
+//
+// The supplied ctx bounds sending of msg; if it ends before msg is sent,
+// msg is not sent. Sending is also canceled when the Scheduler is stopped.
+// Messages are sent from the goroutine of the Scheduler, therefore Mailboxes
+// which block senders delay sending of other scheduled messages.
+//
+// The returned function cancels sending of msg. It reports whether sending
+// was canceled before msg was sent.
+func SendAfter[T any](
+	ctx Context,
+	s Scheduler,
+	mbx MailboxSender[T],
+	msg T,
+	d time.Duration,
+) func() bool {
+	return s.Schedule(d, func(schedulerCtx Context) {
+		sendCtx, cancel := gocontext.WithCancel(ctx)
+		defer cancel()
+
+		stop := gocontext.AfterFunc(schedulerCtx, cancel)
+		defer stop()
+
+		mbx.Send(sendCtx, msg) //nolint:errcheck // relax
+	})
+}
+
+type scheduler struct {
+	*actor
+	worker *schedulerWorker
+}
+
+func (s *scheduler) Schedule(d time.Duration, fn func(Context)) func() bool {
+	return s.worker.schedule(d, fn)
+}
+
+type schedulerWorker struct {
+	clock    Clock
+	timer    Timer
+	lock     sync.Mutex
+	entries  scheduledEntries
+	seq      uint64
+	wakeSigC chan struct{}
+}
+
+func newSchedulerWorker(options optionsTimer) *schedulerWorker {
+	return &schedulerWorker{
+		clock:    options.Clock,
+		wakeSigC: make(chan struct{}, 1),
+	}
+}
+
+func (w *schedulerWorker) schedule(d time.Duration, fn func(Context)) func() bool {
+	w.lock.Lock()
+
+	e := &scheduledEntry{
+		at:  w.clock.Now().Add(d),
+		seq: w.seq,
+		fn:  fn,
+	}
+	w.seq++
+	heap.Push(&w.entries, e)
+
+	isFirst := e.index == 0
+
+	w.lock.Unlock()
+
+	// worker is woken up only when it should wait for shorter time
+	if isFirst {
+		select {
+		case w.wakeSigC <- struct{}{}:
+		default:
+		}
+	}
+
+	return func() bool {
+		return w.cancel(e)
+	}
+}
+
+func (w *schedulerWorker) cancel(e *scheduledEntry) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if e.index < 0 {
+		return false
+	}
+
+	heap.Remove(&w.entries, e.index)
+
+	return true
+}
+
+func (w *schedulerWorker) DoWork(ctx Context) WorkerStatus {
+	// due functions are not called once scheduler is stopping
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+	default:
+	}
+
+	e, wait, ok := w.next()
+	if e != nil {
+		e.fn(ctx)
+		return WorkerContinue
+	}
+
+	var timerC <-chan time.Time
+
+	if ok {
+		if w.timer == nil {
+			w.timer = w.clock.NewTimer(wait)
+		} else {
+			resetTimer(w.timer, wait)
+		}
+
+		timerC = w.timer.C()
+	}
+
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+	case <-w.wakeSigC:
+	case <-timerC:
+	}
+
+	return WorkerContinue
+}
+
+// next removes and returns entry which is due. When no entry is due,
+// it returns time to wait for the first entry, or false if there are
+// no entries.
+func (w *schedulerWorker) next() (*scheduledEntry, time.Duration, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.entries) == 0 {
+		return nil, 0, false
+	}
+
+	wait := w.entries[0].at.Sub(w.clock.Now())
+	if wait > 0 {
+		return nil, wait, true
+	}
+
+	e, _ := heap.Pop(&w.entries).(*scheduledEntry)
+
+	return e, 0, true
+}
+
+func (w *schedulerWorker) OnStop() {
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
+
+type scheduledEntry struct {
+	at    time.Time
+	seq   uint64
+	fn    func(Context)
+	index int
+}
+
+// scheduledEntries implements heap.Interface ordering entries by their
+// due time, while entries with the same due time are ordered by the
+// sequence in which they were scheduled.
+type scheduledEntries []*scheduledEntry
+
+func (s *scheduledEntries) Len() int {
+	return len(*s)
+}
+
+func (s *scheduledEntries) Less(i, j int) bool {
+	a, b := (*s)[i], (*s)[j]
+	if a.at.Equal(b.at) {
+		return a.seq < b.seq
+	}
+
+	return a.at.Before(b.at)
+}
+
+func (s *scheduledEntries) Swap(i, j int) {
+	(*s)[i], (*s)[j] = (*s)[j], (*s)[i]
+	(*s)[i].index = i
+	(*s)[j].index = j
+}
+
+func (s *scheduledEntries) Push(x any) {
+	e, _ := x.(*scheduledEntry)
+	e.index = len(*s)
+	*s = append(*s, e)
+}
+
+func (s *scheduledEntries) Pop() any {
+	old := *s
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	e.index = -1
+	*s = old[:n-1]
+
+	return e
+}
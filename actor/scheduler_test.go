@@ -0,0 +1,165 @@
+package actor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that scheduler sends messages in order of their due time.
+func Test_Scheduler_SendAfter(t *testing.T) {
+	t.Parallel()
+
+	s := NewScheduler()
+	m := NewMailbox[int]()
+
+	a := Combine(s, m).Build()
+	a.Start()
+	defer a.Stop()
+
+	scheduledAt := time.Now()
+
+	SendAfter(ContextStarted(), s, m, 3, time.Millisecond*30)
+	SendAfter(ContextStarted(), s, m, 1, time.Millisecond*10)
+	SendAfter(ContextStarted(), s, m, 2, time.Millisecond*20)
+	SendAfter(ContextStarted(), s, m, 4, time.Millisecond*30)
+
+	for i := 1; i <= 4; i++ {
+		assert.Equal(t, i, <-m.ReceiveC())
+	}
+
+	assert.GreaterOrEqual(t, time.Since(scheduledAt), time.Millisecond*30)
+}
+
+// Test asserts that scheduled messages can be canceled.
+func Test_Scheduler_Cancel(t *testing.T) {
+	t.Parallel()
+
+	s := NewScheduler()
+	m := NewMailbox[int]()
+
+	a := Combine(s, m).Build()
+	a.Start()
+	defer a.Stop()
+
+	cancel := SendAfter(ContextStarted(), s, m, 1, time.Millisecond*10)
+	assert.True(t, cancel())
+	assert.False(t, cancel())
+
+	cancel = SendAfter(ContextStarted(), s, m, 2, time.Millisecond*20)
+	assert.Equal(t, 2, <-m.ReceiveC())
+	assert.False(t, cancel())
+
+	assertNoMessage(t, m, time.Millisecond*20)
+}
+
+// Test asserts that messages scheduled before scheduler has started
+// are sent once it is started, and that they can be scheduled
+// with injected clock.
+func Test_Scheduler_Clock(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	s := NewScheduler(OptClock(clock))
+	m := NewMailbox[int]()
+
+	SendAfter(ContextStarted(), s, m, 2, time.Hour*2)
+	SendAfter(ContextStarted(), s, m, 1, time.Hour)
+
+	a := Combine(s, m).Build()
+	a.Start()
+	defer a.Stop()
+
+	assertNoMessage(t, m, time.Millisecond*10)
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, 1, receiveAdvancing(clock, m))
+	assertNoMessage(t, m, time.Millisecond*10)
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, 2, receiveAdvancing(clock, m))
+}
+
+// Test asserts that scheduled message is not sent when context,
+// supplied to SendAfter, has ended.
+func Test_Scheduler_ContextEnded(t *testing.T) {
+	t.Parallel()
+
+	sink := newDeadLetterSink(t)
+	s := NewScheduler()
+	m := NewMailbox[int](OptDeadLetter(sink), OptMaxLen(1))
+
+	a := Combine(s, m).Build()
+	a.Start()
+	defer a.Stop()
+
+	// Filling mailbox ensures that ended context is noticed when sending
+	assert.NoError(t, m.Send(ContextStarted(), 1))
+
+	SendAfter(ContextEnded(), s, m, 2, 0)
+	assertDeadLetter(t, sink, 2, ContextEnded().Err())
+}
+
+// Test asserts that scheduler can be stopped while it is sending
+// message to mailbox which blocks senders.
+func Test_Scheduler_StopWhileSending(t *testing.T) {
+	t.Parallel()
+
+	s := NewScheduler()
+	m := NewMailbox[int](OptMaxLen(1))
+	m.Start()
+
+	defer m.Stop()
+
+	s.Start()
+	assert.NoError(t, m.Send(ContextStarted(), 1))
+
+	sentC := make(chan any)
+	SendAfter(ContextStarted(), s, m, 2, 0)
+	s.Schedule(0, func(Context) { close(sentC) })
+
+	assert.NoError(t, StopWithTimeout(s, time.Second))
+	assertNoSignal(t, sentC)
+
+	// Scheduled functions are called once scheduler is started again
+	assert.Equal(t, 1, <-m.ReceiveC())
+	s.Start()
+	assertSignal(t, sentC)
+	s.Stop()
+}
+
+func Test_Scheduler_Invariants(t *testing.T) {
+	t.Parallel()
+
+	s := NewScheduler()
+	AssertStartStopAtRandom(t, s)
+
+	w := s.(Waiter) //nolint:forcetypeassert // relax
+	assertDone(t, w.Done())
+}
+
+// receiveAdvancing receives message from mailbox, while waking up
+// scheduler until message is received.
+func receiveAdvancing(clock *fakeClock, m Mailbox[int]) int {
+	for {
+		select {
+		case v := <-m.ReceiveC():
+			return v
+		case <-time.After(time.Millisecond):
+			clock.Advance(0)
+		}
+	}
+}
+
+func assertNoMessage(t *testing.T, m Mailbox[int], d time.Duration) {
+	t.Helper()
+
+	select {
+	case v := <-m.ReceiveC():
+		assert.Fail(t, "unexpected message", v)
+	case <-time.After(d):
+	}
+}
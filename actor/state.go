@@ -0,0 +1,167 @@
+package actor
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// State represents the lifecycle state of an Actor.
+type State int32
+
+const (
+	// StateNotStarted is the state of an Actor that has never been started.
+	StateNotStarted State = 0
+
+	// StateRunning is the state of an Actor that has been started.
+	StateRunning State = 1
+
+	// StateStopping is the state of an Actor that is terminating, either because
+	// Stop() was called or because it is ending on its own.
+	StateStopping State = 2
+
+	// StateStopped is the state of an Actor that has terminated.
+	StateStopped State = 3
+)
+
+func (s State) String() string {
+	switch s {
+	case StateNotStarted:
+		return "not-started"
+	case StateRunning:
+		return "running"
+	case StateStopping:
+		return "stopping"
+	case StateStopped:
+		return "stopped"
+	}
+
+	return "unknown"
+}
+
+// Stateful defines an optional interface implemented by Actors
+// that can report their lifecycle state.
+type Stateful interface {
+	// State returns the current lifecycle state of the Actor.
+	State() State
+
+	// SubscribeState registers fn to be called with the new state whenever
+	// the state of the Actor changes. The returned function unsubscribes fn.
+	//
+	// State changes are delivered in the order they occurred, one at a time.
+	// They are delivered from a goroutine which has changed the state, though
+	// not necessarily the one which has made the particular change, because
+	// changes made while subscribers are being notified are delivered by the
+	// goroutine which is already notifying them. Therefore fn should not block,
+	// as it could delay other subscribers and the Actor itself.
+	SubscribeState(fn func(State)) func()
+}
+
+// stateTracker holds the state of an Actor and notifies subscribers
+// about its changes.
+//
+// State is changed with set and compareAndSet, which can be called while
+// holding locks of the Actor, while subscribers are notified by calling
+// notify after these locks have been released. This way subscribers are
+// free to call methods of the Actor.
+type stateTracker struct {
+	state       atomic.Int32
+	lock        sync.Mutex
+	subscribers []stateSubscriber
+	nextID      uint64
+	pending     []State
+	notifying   bool
+}
+
+type stateSubscriber struct {
+	id uint64
+	fn func(State)
+}
+
+func (t *stateTracker) get() State {
+	return State(t.state.Load())
+}
+
+func (t *stateTracker) set(s State) bool {
+	return t.update(s, func(State) bool { return true })
+}
+
+func (t *stateTracker) compareAndSet(from, to State) bool {
+	return t.update(to, func(s State) bool { return s == from })
+}
+
+func (t *stateTracker) update(to State, allow func(from State) bool) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	from := t.get()
+	if from == to || !allow(from) {
+		return false
+	}
+
+	t.state.Store(int32(to))
+
+	if len(t.subscribers) > 0 {
+		t.pending = append(t.pending, to)
+	}
+
+	return true
+}
+
+// notify delivers pending state changes to subscribers. If another goroutine
+// is already delivering them, notify returns immediately and pending changes
+// are delivered by that goroutine.
+func (t *stateTracker) notify() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.notifying {
+		return
+	}
+
+	t.notifying = true
+
+	for len(t.pending) > 0 {
+		s := t.pending[0]
+		t.pending = t.pending[1:]
+		subscribers := t.subscribers
+
+		t.lock.Unlock()
+
+		for _, sub := range subscribers {
+			sub.fn(s)
+		}
+
+		t.lock.Lock()
+	}
+
+	t.notifying = false
+}
+
+func (t *stateTracker) subscribe(fn func(State)) func() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.nextID++
+	id := t.nextID
+
+	// subscribers slice is copied on every change, so that notify
+	// can iterate over it without holding the lock
+	subscribers := make([]stateSubscriber, len(t.subscribers), len(t.subscribers)+1)
+	copy(subscribers, t.subscribers)
+	t.subscribers = append(subscribers, stateSubscriber{id: id, fn: fn})
+
+	return func() {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+
+		subscribers := make([]stateSubscriber, 0, len(t.subscribers))
+
+		for _, sub := range t.subscribers {
+			if sub.id != id {
+				subscribers = append(subscribers, sub)
+			}
+		}
+
+		t.subscribers = subscribers
+	}
+}
@@ -0,0 +1,206 @@
+package actor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+func Test_State_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "not-started", StateNotStarted.String())
+	assert.Equal(t, "running", StateRunning.String())
+	assert.Equal(t, "stopping", StateStopping.String())
+	assert.Equal(t, "stopped", StateStopped.String())
+	assert.Equal(t, "unknown", State(-1).String())
+}
+
+// Test asserts that actor reports its state and notifies subscribers
+// about state changes.
+func Test_Actor_State(t *testing.T) {
+	t.Parallel()
+
+	endC := make(chan any)
+	a := New(NewWorker(func(ctx Context) WorkerStatus {
+		select {
+		case <-ctx.Done():
+		case <-endC:
+		}
+
+		return WorkerEnd
+	}))
+	s := a.(Stateful) //nolint:forcetypeassert // relax
+	statesC := subscribeState(s)
+
+	assert.Equal(t, StateNotStarted, s.State())
+
+	for range 3 {
+		a.Start()
+		assert.Equal(t, StateRunning, <-statesC)
+
+		a.Stop()
+		assert.Equal(t, StateStopping, <-statesC)
+		assert.Equal(t, StateStopped, <-statesC)
+		assert.Equal(t, StateStopped, s.State())
+	}
+
+	// Actor is stopping on its own
+	a.Start()
+	assert.Equal(t, StateRunning, <-statesC)
+
+	endC <- `🛑`
+	assert.Equal(t, StateStopping, <-statesC)
+	assert.Equal(t, StateStopped, <-statesC)
+	assert.Equal(t, StateStopped, s.State())
+}
+
+// Test asserts that idle actor reports its state.
+func Test_Idle_State(t *testing.T) {
+	t.Parallel()
+
+	a := Idle()
+	s := a.(Stateful) //nolint:forcetypeassert // relax
+	statesC := subscribeState(s)
+
+	assert.Equal(t, StateNotStarted, s.State())
+
+	for range 3 {
+		a.Start()
+		assert.Equal(t, StateRunning, <-statesC)
+		assert.Equal(t, StateRunning, s.State())
+
+		a.Stop()
+		assert.Equal(t, StateStopping, <-statesC)
+		assert.Equal(t, StateStopped, <-statesC)
+		assert.Equal(t, StateStopped, s.State())
+	}
+}
+
+// Test asserts that combined actor reports its state.
+func Test_Combine_State(t *testing.T) {
+	t.Parallel()
+
+	{ // Combined actor is stopped
+		a := Combine(createActors(10)...).Build()
+		s := a.(Stateful) //nolint:forcetypeassert // relax
+		statesC := subscribeState(s)
+
+		assert.Equal(t, StateNotStarted, s.State())
+
+		for range 3 {
+			a.Start()
+			assert.Equal(t, StateRunning, <-statesC)
+			assert.Equal(t, StateRunning, s.State())
+
+			a.Stop()
+			assert.Equal(t, StateStopping, <-statesC)
+			assert.Equal(t, StateStopped, <-statesC)
+			assert.Equal(t, StateStopped, s.State())
+		}
+	}
+
+	{ // All combined actors have ended on their own
+		a := Combine(
+			New(NewWorker(func(Context) WorkerStatus { return WorkerEnd })),
+			New(NewWorker(func(Context) WorkerStatus { return WorkerEnd })),
+		).Build()
+		s := a.(Stateful) //nolint:forcetypeassert // relax
+		statesC := subscribeState(s)
+
+		a.Start()
+		assert.Equal(t, StateRunning, <-statesC)
+		assert.Equal(t, StateStopped, <-statesC)
+		assert.Equal(t, StateStopped, s.State())
+	}
+}
+
+// Test asserts that mailbox reports its state.
+func Test_Mailbox_State(t *testing.T) {
+	t.Parallel()
+
+	for _, m := range []Mailbox[any]{NewMailbox[any](), NewMailbox[any](OptAsChan())} {
+		s := m.(Stateful) //nolint:forcetypeassert // relax
+		statesC := subscribeState(s)
+
+		assert.Equal(t, StateNotStarted, s.State())
+
+		m.Start()
+		assert.Equal(t, StateRunning, <-statesC)
+		assert.Equal(t, StateRunning, s.State())
+
+		m.Stop()
+		assert.Equal(t, StateStopping, <-statesC)
+		assert.Equal(t, StateStopped, <-statesC)
+		assert.Equal(t, StateStopped, s.State())
+
+		// Mailbox can not be restarted
+		m.Start()
+		assert.Equal(t, StateStopped, s.State())
+		assert.Empty(t, statesC)
+	}
+}
+
+// Test asserts that unsubscribed function is not notified about state changes.
+func Test_SubscribeState_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	a := Idle()
+	s := a.(Stateful) //nolint:forcetypeassert // relax
+
+	firstC := make(chan State, 10)
+	unsubscribe := s.SubscribeState(func(state State) { firstC <- state })
+	secondC := subscribeState(s)
+
+	a.Start()
+	assert.Equal(t, StateRunning, <-firstC)
+	assert.Equal(t, StateRunning, <-secondC)
+
+	unsubscribe()
+	unsubscribe() // should have no effect
+
+	a.Stop()
+	assert.Equal(t, StateStopping, <-secondC)
+	assert.Equal(t, StateStopped, <-secondC)
+	assert.Empty(t, firstC)
+}
+
+// Test asserts that subscriber is able to call actor methods
+// when it is notified about state change.
+func Test_SubscribeState_Reentrant(t *testing.T) {
+	t.Parallel()
+
+	for _, a := range []Actor{
+		New(NewWorker(func(Context) WorkerStatus { return WorkerEnd })),
+		Idle(),
+		Combine(createActors(3)...).Build(),
+		NewMailbox[any](),
+		NewMailbox[any](OptAsChan()),
+	} {
+		s := a.(Stateful) //nolint:forcetypeassert // relax
+		statesC := make(chan State, 10)
+
+		s.SubscribeState(func(state State) {
+			statesC <- state
+
+			if state == StateRunning {
+				a.Stop()
+			}
+		})
+
+		a.Start()
+		assert.Equal(t, StateRunning, <-statesC)
+		assert.Equal(t, StateStopping, <-statesC)
+		assert.Equal(t, StateStopped, <-statesC)
+		assert.Equal(t, StateStopped, s.State())
+	}
+}
+
+func subscribeState(s Stateful) <-chan State {
+	statesC := make(chan State, 10)
+	s.SubscribeState(func(state State) { statesC <- state })
+
+	return statesC
+}
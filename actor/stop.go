@@ -0,0 +1,153 @@
+package actor
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ContextStopper defines an optional interface implemented by Actors
+// that can be stopped within the bounds of a Context.
+type ContextStopper interface {
+	// StopContext signals the Actor to stop execution and blocks the calling
+	// goroutine until the Actor has terminated or the supplied ctx has ended.
+	//
+	// When ctx ends before the Actor has terminated, StopContext returns
+	// a *StopTimeoutError. In this case the Actor is not abandoned; it continues
+	// to terminate in the background.
+	StopContext(ctx Context) error
+}
+
+// ErrStopTimeout is wrapped by errors returned when an Actor
+// has not stopped in time.
+var ErrStopTimeout = errors.New("actor stop timed out")
+
+// StopTimeoutError is the error returned when an Actor has not stopped
+// before the Context supplied to StopContext has ended.
+//
+// StopTimeoutError wraps both ErrStopTimeout and the error of the Context.
+type StopTimeoutError struct {
+	// Pending holds indexes of combined Actors, in the order they were
+	// supplied to Combine, which have not stopped in time. It is nil
+	// for Actors which are not combined.
+	Pending []int
+
+	// Err is the error of the Context supplied to StopContext.
+	Err error
+}
+
+func (e *StopTimeoutError) Error() string {
+	if len(e.Pending) > 0 {
+		return fmt.Sprintf("%v (pending actors %v): %v", ErrStopTimeout, e.Pending, e.Err)
+	}
+
+	return fmt.Sprintf("%v: %v", ErrStopTimeout, e.Err)
+}
+
+func (e *StopTimeoutError) Unwrap() []error {
+	return []error{ErrStopTimeout, e.Err}
+}
+
+// StopWithTimeout stops the supplied Actor and waits at most timeout
+// for it to terminate.
+//
+// Actors which implement the ContextStopper interface are stopped with
+// StopContext. Other Actors are stopped by calling Stop() in a separate
+// goroutine. In both cases a *StopTimeoutError is returned if the Actor has
+// not terminated in time.
+func StopWithTimeout(a Actor, timeout time.Duration) error {
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), timeout)
+	defer cancel()
+
+	if s, ok := a.(ContextStopper); ok {
+		return s.StopContext(ctx)
+	}
+
+	return stopWithContext(ctx, a.Stop)
+}
+
+// stopWithContext calls stop in a separate goroutine and waits
+// until it returns or ctx ends.
+func stopWithContext(ctx Context, stop func()) error {
+	stoppedC := make(chan struct{})
+
+	go func() {
+		stop()
+		close(stoppedC)
+	}()
+
+	return waitStopped(ctx, stoppedC, nil)
+}
+
+// stopAllContext stops actors, in parallel or sequentially, and waits
+// until all of them have stopped or ctx ends.
+func stopAllContext(ctx Context, actors []Actor, parallel bool) error {
+	stopped := make([]atomic.Bool, len(actors))
+	stoppedC := make(chan struct{})
+
+	stop := func(i int) {
+		actors[i].Stop()
+		stopped[i].Store(true)
+	}
+
+	go func() {
+		defer close(stoppedC)
+
+		if parallel {
+			wg := sync.WaitGroup{}
+			wg.Add(len(actors))
+
+			for i := range actors {
+				go func() {
+					stop(i)
+					wg.Done()
+				}()
+			}
+
+			wg.Wait()
+
+			return
+		}
+
+		for i := range actors {
+			stop(i)
+		}
+	}()
+
+	return waitStopped(ctx, stoppedC, func() []int {
+		var pending []int
+
+		for i := range stopped {
+			if !stopped[i].Load() {
+				pending = append(pending, i)
+			}
+		}
+
+		return pending
+	})
+}
+
+func waitStopped(ctx Context, stoppedC <-chan struct{}, pending func() []int) error {
+	select {
+	case <-stoppedC:
+		return nil
+	case <-ctx.Done():
+	}
+
+	// actor could have stopped at the same time when ctx has ended
+	select {
+	case <-stoppedC:
+		return nil
+	default:
+	}
+
+	err := &StopTimeoutError{Err: ctx.Err()}
+	if pending != nil {
+		err.Pending = pending()
+	}
+
+	return err
+}
@@ -0,0 +1,167 @@
+package actor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+const stopTimeout = time.Millisecond * 20
+
+func Test_StopTimeoutError(t *testing.T) {
+	t.Parallel()
+
+	err := &StopTimeoutError{Err: context.DeadlineExceeded}
+	assert.ErrorIs(t, err, ErrStopTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, "actor stop timed out: context deadline exceeded", err.Error())
+
+	err = &StopTimeoutError{Pending: []int{1, 2}, Err: context.Canceled}
+	assert.ErrorIs(t, err, ErrStopTimeout)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t,
+		"actor stop timed out (pending actors [1 2]): context canceled",
+		err.Error(),
+	)
+}
+
+// Test asserts that StopWithTimeout stops actors which are stopping in time.
+func Test_StopWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	for _, a := range []Actor{
+		New(newWorker()),
+		Idle(),
+		Combine(createActors(3)...).Build(),
+		Combine(createActors(3)...).WithOptions(OptStopParallel()).Build(),
+		NewMailbox[any](),
+		NewMailbox[any](OptAsChan()),
+		Noop(),
+	} {
+		// Stopping actor which was not started should have no effect
+		assert.NoError(t, StopWithTimeout(a, time.Second))
+
+		a.Start()
+		assert.NoError(t, StopWithTimeout(a, time.Second))
+
+		if w, ok := a.(Waiter); ok {
+			assertDone(t, w.Done())
+		}
+	}
+}
+
+// Test asserts that StopContext returns error when actor
+// has not stopped in time.
+func Test_Actor_StopContext_Timeout(t *testing.T) {
+	t.Parallel()
+
+	stuck := newStuckActor()
+	a := stuck.actor
+	a.Start()
+	assertSignal(t, stuck.workC)
+
+	err := StopWithTimeout(a, stopTimeout)
+	assert.ErrorIs(t, err, ErrStopTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	var timeoutErr *StopTimeoutError
+
+	assert.ErrorAs(t, err, &timeoutErr)
+	assert.Nil(t, timeoutErr.Pending)
+
+	// Actor should continue stopping in background
+	w := a.(Waiter) //nolint:forcetypeassert // relax
+	assertNotDone(t, w.Done())
+	close(stuck.releaseC)
+	assertDone(t, w.Done())
+}
+
+// Test asserts that StopContext of combined actor returns error
+// listing actors which have not stopped in time.
+func Test_Combine_StopContext_Timeout(t *testing.T) {
+	t.Parallel()
+
+	for _, parallel := range []bool{false, true} {
+		stuck := newStuckActor()
+		a := Combine(New(newWorker()), stuck.actor, New(newWorker())).
+			WithOptions(OptStopParallelWith(parallel)).
+			Build()
+		a.Start()
+		assertSignal(t, stuck.workC)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(stopTimeout) //nolint:forbidigo // waiting for actors to stop
+			cancel()
+		}()
+
+		err := a.(ContextStopper).StopContext(ctx) //nolint:forcetypeassert // relax
+		assert.ErrorIs(t, err, ErrStopTimeout)
+		assert.ErrorIs(t, err, context.Canceled)
+
+		var timeoutErr *StopTimeoutError
+
+		assert.ErrorAs(t, err, &timeoutErr)
+
+		if parallel {
+			assert.Equal(t, []int{1}, timeoutErr.Pending)
+		} else {
+			assert.Equal(t, []int{1, 2}, timeoutErr.Pending)
+		}
+
+		// Remaining actors should continue stopping in background
+		w := a.(Waiter) //nolint:forcetypeassert // relax
+		assertNotDone(t, w.Done())
+		close(stuck.releaseC)
+		assertDone(t, w.Done())
+
+		// Stopping again should have no effect
+		assert.NoError(t, StopWithTimeout(a, stopTimeout))
+	}
+}
+
+// Test asserts that StopWithTimeout returns error when actor,
+// which does not implement ContextStopper, has not stopped in time.
+func Test_StopWithTimeout_OtherActors(t *testing.T) {
+	t.Parallel()
+
+	releaseC := make(chan any)
+	stoppedC := make(chan any)
+	a := delegateActor{stop: func() {
+		<-releaseC
+		close(stoppedC)
+	}}
+
+	err := StopWithTimeout(a, stopTimeout)
+	assert.ErrorIs(t, err, ErrStopTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(releaseC)
+	assertSignal(t, stoppedC)
+}
+
+type stuckActor struct {
+	actor    Actor
+	workC    chan any
+	releaseC chan any
+}
+
+// newStuckActor returns actor which does not stop until releaseC is closed.
+func newStuckActor() stuckActor {
+	s := stuckActor{
+		workC:    make(chan any, 1),
+		releaseC: make(chan any),
+	}
+	s.actor = New(NewWorker(func(Context) WorkerStatus {
+		s.workC <- `🛠️`
+		<-s.releaseC
+
+		return WorkerEnd
+	}))
+
+	return s
+}
@@ -0,0 +1,263 @@
+package actor
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RestartStrategy defines which children of a supervisor are restarted
+// when one of them terminates on its own.
+type RestartStrategy int8
+
+const (
+	// OneForOne restarts only the child that has terminated.
+	OneForOne RestartStrategy = 1
+
+	// OneForAll restarts all children when any child terminates.
+	OneForAll RestartStrategy = 2
+
+	// RestForOne restarts the terminated child and all children
+	// that were supplied after it.
+	RestForOne RestartStrategy = 3
+)
+
+// ErrMaxRestartsExceeded is wrapped by the termination reason of a supervisor
+// which has ended because its children were restarted too often. As with other
+// Actors whose Worker has failed, the termination reason wraps ErrWorkerFailed
+// as well.
+var ErrMaxRestartsExceeded = errors.New("supervisor max restarts exceeded")
+
+const (
+	defaultMaxRestarts   = 3
+	defaultRestartWindow = 5 * time.Second
+)
+
+// Supervise returns a builder that creates a supervisor Actor for the
+// specified child Actors.
+//
+// A supervisor starts its children in the order they were provided and stops
+// them in reverse order. When a child terminates on its own (for example, its
+// Worker has returned WorkerEnd), the supervisor restarts it according to the
+// configured RestartStrategy. If children are restarted more often than allowed
+// by OptMaxRestarts, the supervisor stops all children and ends itself with
+// a termination reason wrapping ErrWorkerFailed and ErrMaxRestartsExceeded.
+//
+// Children that recover from panics with OptRecover(RecoverEnd, ...) terminate
+// on their own and are therefore restarted as well.
+//
+// Only Actors which are able to signal their own termination can be restarted;
+// these are Actors created with New, Combine and Supervise, and Actors of this
+// package which are built on them, such as Merger, Pipeline and worker pools.
+// Other Actors, such as Mailboxes, are started and stopped together with the
+// supervisor, but never restarted, even when all children are restarted by
+// the RestartStrategy.
+func Supervise(children ...Actor) *SupervisorBuilder {
+	return &SupervisorBuilder{
+		children: children,
+	}
+}
+
+type SupervisorBuilder struct {
+	children []Actor
+	options  options
+}
+
+// Build returns the supervisor Actor created by the SupervisorBuilder.
+//
+// The returned Actor can be restarted, in which case all children
+// are started again.
+func (b *SupervisorBuilder) Build() Actor {
+	options := b.options.Supervisor
+
+	if options.Strategy == 0 {
+		options.Strategy = OneForOne
+	}
+
+	// OptMaxRestarts always sets positive window,
+	// therefore zero window means that option was not supplied
+	if options.RestartWindow == 0 {
+		options.MaxRestarts = defaultMaxRestarts
+		options.RestartWindow = defaultRestartWindow
+	}
+
+	return New(FromErrWorker(newSupervisorWorker(b.children, options)))
+}
+
+// WithOptions adds configuration options for the supervisor Actor.
+func (b *SupervisorBuilder) WithOptions(opt ...SupervisorOption) *SupervisorBuilder {
+	b.options = newOptions(opt)
+	return b
+}
+
+type childEvent struct {
+	index      int
+	generation uint64
+}
+
+type supervisorWorker struct {
+	children    []Actor
+	restartable []bool
+	options     optionsSupervisor
+	stopping    []atomic.Bool
+	generation  []atomic.Uint64
+	restarts    []time.Time
+	events      []childEvent
+	eventsLock  sync.Mutex
+	eventSigC   chan struct{}
+}
+
+func newSupervisorWorker(
+	children []Actor,
+	options optionsSupervisor,
+) *supervisorWorker {
+	w := &supervisorWorker{
+		children:    make([]Actor, len(children)),
+		restartable: make([]bool, len(children)),
+		options:     options,
+		stopping:    make([]atomic.Bool, len(children)),
+		generation:  make([]atomic.Uint64, len(children)),
+		eventSigC:   make(chan struct{}, 1),
+	}
+
+	for i, c := range children {
+		_, w.restartable[i] = c.(stopHooker)
+
+		w.children[i] = wrapActors([]Actor{c}, func(error) { w.onChildStopped(i) })[0]
+	}
+
+	return w
+}
+
+// onChildStopped is invoked whenever child has stopped, regardless
+// if it was stopped by supervisor or it has terminated on its own.
+func (w *supervisorWorker) onChildStopped(i int) {
+	// children stopped by supervisor should not be restarted
+	if w.stopping[i].Load() {
+		return
+	}
+
+	w.eventsLock.Lock()
+	w.events = append(w.events, childEvent{
+		index:      i,
+		generation: w.generation[i].Load(),
+	})
+	w.eventsLock.Unlock()
+
+	select {
+	case w.eventSigC <- struct{}{}:
+	default:
+	}
+}
+
+func (w *supervisorWorker) takeEvents() []childEvent {
+	w.eventsLock.Lock()
+	defer w.eventsLock.Unlock()
+
+	events := w.events
+	w.events = nil
+
+	return events
+}
+
+func (w *supervisorWorker) OnStart(Context) {
+	w.takeEvents()
+	w.restarts = nil
+
+	w.startChildren(w.indexes(0, len(w.children)))
+}
+
+func (w *supervisorWorker) DoWork(ctx Context) (WorkerStatus, error) {
+	select {
+	case <-ctx.Done():
+		return WorkerEnd, nil
+
+	case <-w.eventSigC:
+		for _, e := range w.takeEvents() {
+			// event is stale if child was restarted after it has been created
+			if e.generation != w.generation[e.index].Load() {
+				continue
+			}
+
+			if !w.allowRestart() {
+				return WorkerEnd, ErrMaxRestartsExceeded
+			}
+
+			w.restart(e.index)
+		}
+
+		return WorkerContinue, nil
+	}
+}
+
+func (w *supervisorWorker) OnStop() {
+	w.stopChildren(w.indexes(0, len(w.children)))
+}
+
+// allowRestart records restart attempt and reports whether
+// restart intensity is within configured limits.
+func (w *supervisorWorker) allowRestart() bool {
+	now := time.Now()
+
+	n := 0
+
+	for _, t := range w.restarts {
+		if now.Sub(t) < w.options.RestartWindow {
+			w.restarts[n] = t
+			n++
+		}
+	}
+
+	w.restarts = append(w.restarts[:n], now)
+
+	return len(w.restarts) <= w.options.MaxRestarts
+}
+
+func (w *supervisorWorker) restart(i int) {
+	first, last := i, i+1
+
+	switch w.options.Strategy {
+	case OneForOne:
+	case OneForAll:
+		first, last = 0, len(w.children)
+	case RestForOne:
+		last = len(w.children)
+	}
+
+	// children which are not able to signal their termination may not
+	// be able to start again (i.e. Mailboxes), therefore they are skipped
+	restarted := slices.DeleteFunc(w.indexes(first, last), func(i int) bool {
+		return !w.restartable[i]
+	})
+
+	w.stopChildren(restarted)
+	w.startChildren(restarted)
+}
+
+// indexes returns indexes of children in range [first, last).
+func (w *supervisorWorker) indexes(first, last int) []int {
+	indexes := make([]int, 0, last-first)
+	for i := first; i < last; i++ {
+		indexes = append(indexes, i)
+	}
+
+	return indexes
+}
+
+func (w *supervisorWorker) startChildren(indexes []int) {
+	for _, i := range indexes {
+		w.generation[i].Add(1)
+		w.children[i].Start()
+	}
+}
+
+func (w *supervisorWorker) stopChildren(indexes []int) {
+	for j := len(indexes) - 1; j >= 0; j-- {
+		i := indexes[j]
+		w.stopping[i].Store(true)
+		w.children[i].Stop()
+		w.stopping[i].Store(false)
+	}
+}
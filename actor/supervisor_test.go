@@ -0,0 +1,346 @@
+package actor_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+func Test_Supervise_TestSuite(t *testing.T) {
+	t.Parallel()
+
+	for _, count := range []int{0, 1, 2, 10} {
+		t.Run(fmt.Sprintf("children count %v", count), func(t *testing.T) {
+			t.Parallel()
+
+			TestSuite(t, func() Actor {
+				return Supervise(createActors(count)...).Build()
+			})
+		})
+	}
+}
+
+// Test asserts that supervisor starts and stops all children.
+func Test_Supervise_StartStop(t *testing.T) {
+	t.Parallel()
+
+	children := newSupervisedChildren(3)
+	s := Supervise(supervisedActors(children)...).Build()
+
+	for range 3 {
+		s.Start()
+
+		for _, c := range children {
+			assertSignal(t, c.startC)
+		}
+
+		s.Stop()
+
+		for _, c := range children {
+			assertSignal(t, c.stopC)
+			assertNoSignal(t, c.startC)
+		}
+	}
+}
+
+// Test asserts that children which are not able to signal their termination
+// are started and stopped with supervisor.
+func Test_Supervise_OtherActors(t *testing.T) {
+	t.Parallel()
+
+	onStartC, onStartFn := createOnStartOption(t, 1)
+	onStopC, onStopFn := createOnStopOption(t, 1)
+	children := newSupervisedChildren(1)
+	s := Supervise(
+		Idle(OptOnStart(onStartFn), OptOnStop(onStopFn)),
+		children[0].actor,
+	).WithOptions(OptRestartStrategy(OneForAll)).Build()
+
+	s.Start()
+	assert.Equal(t, `🌞`, <-onStartC)
+	assertSignal(t, children[0].startC)
+
+	// Restarting all children should not restart idle actor
+	children[0].endC <- `🛑`
+	assertSignal(t, children[0].stopC)
+	assertSignal(t, children[0].startC)
+	assert.Empty(t, onStopC)
+
+	s.Stop()
+	assert.Equal(t, `🌚`, <-onStopC)
+}
+
+// Test asserts that mailbox, which is supervised together with its consumer,
+// is not restarted when all children are restarted.
+func Test_Supervise_MailboxSibling(t *testing.T) {
+	t.Parallel()
+
+	mbx := NewMailbox[int]()
+	receivedC := make(chan int, 10)
+	consumer := New(NewWorker(func(ctx Context) WorkerStatus {
+		select {
+		case <-ctx.Done():
+			return WorkerEnd
+		case msg, ok := <-mbx.ReceiveC():
+			if !ok {
+				return WorkerEnd
+			}
+
+			receivedC <- msg
+
+			// consumer ends after first message
+			if msg == 1 {
+				return WorkerEnd
+			}
+
+			return WorkerContinue
+		}
+	}))
+
+	s := Supervise(mbx, consumer).
+		WithOptions(OptRestartStrategy(OneForAll)).
+		Build()
+
+	s.Start()
+	defer s.Stop()
+
+	for i := 1; i <= 3; i++ {
+		if !assert.NoError(t, mbx.Send(ContextStarted(), i)) {
+			return
+		}
+
+		assert.Equal(t, i, <-receivedC)
+	}
+
+	assert.Equal(t, StateRunning, s.(Stateful).State()) //nolint:forcetypeassert // relax
+}
+
+// Test asserts that OneForOne strategy restarts only terminated child.
+func Test_Supervise_OneForOne(t *testing.T) {
+	t.Parallel()
+
+	children := newSupervisedChildren(3)
+	s := Supervise(supervisedActors(children)...).Build()
+
+	s.Start()
+	defer s.Stop()
+
+	assertChildrenStarted(t, children)
+
+	for i, c := range children {
+		c.endC <- `🛑`
+
+		assertSignal(t, c.stopC)
+		assertSignal(t, c.startC)
+
+		for j, other := range children {
+			if i != j {
+				assertNoSignal(t, other.startC)
+			}
+		}
+	}
+}
+
+// Test asserts that OneForAll strategy restarts all children.
+func Test_Supervise_OneForAll(t *testing.T) {
+	t.Parallel()
+
+	children := newSupervisedChildren(3)
+	s := Supervise(supervisedActors(children)...).
+		WithOptions(OptRestartStrategy(OneForAll)).
+		Build()
+
+	s.Start()
+	defer s.Stop()
+
+	assertChildrenStarted(t, children)
+
+	for _, c := range children {
+		c.endC <- `🛑`
+
+		for _, other := range children {
+			assertSignal(t, other.stopC)
+			assertSignal(t, other.startC)
+		}
+	}
+}
+
+// Test asserts that RestForOne strategy restarts terminated child
+// and all children after it.
+func Test_Supervise_RestForOne(t *testing.T) {
+	t.Parallel()
+
+	children := newSupervisedChildren(3)
+	s := Supervise(supervisedActors(children)...).
+		WithOptions(OptRestartStrategy(RestForOne), OptMaxRestarts(10, time.Hour)).
+		Build()
+
+	s.Start()
+	defer s.Stop()
+
+	assertChildrenStarted(t, children)
+
+	for i, c := range children {
+		c.endC <- `🛑`
+
+		for j, other := range children {
+			if j < i {
+				assertNoSignal(t, other.startC)
+				continue
+			}
+
+			assertSignal(t, other.stopC)
+			assertSignal(t, other.startC)
+		}
+	}
+}
+
+// Test asserts that supervisor ends when restart intensity is exceeded.
+func Test_Supervise_MaxRestarts(t *testing.T) {
+	t.Parallel()
+
+	const maxRestarts = 2
+
+	children := newSupervisedChildren(2)
+	s := Supervise(supervisedActors(children)...).
+		WithOptions(OptMaxRestarts(maxRestarts, time.Hour)).
+		Build()
+
+	s.Start()
+	assertChildrenStarted(t, children)
+
+	for range maxRestarts {
+		children[0].endC <- `🛑`
+
+		assertSignal(t, children[0].stopC)
+		assertSignal(t, children[0].startC)
+	}
+
+	// Exceeding restart intensity should stop all children
+	children[0].endC <- `🛑`
+
+	assertSignal(t, children[0].stopC)
+	assertSignal(t, children[1].stopC)
+	assertNoSignal(t, children[0].startC)
+
+	s.Stop() // should have no effect
+	assertNoSignal(t, children[1].stopC)
+
+	reason := s.(Reasoner).Reason() //nolint:forcetypeassert // relax
+	assert.ErrorIs(t, reason, ErrMaxRestartsExceeded)
+	assert.ErrorIs(t, reason, ErrWorkerFailed)
+
+	// Restarting supervisor should reset restart intensity
+	s.Start()
+	assertChildrenStarted(t, children)
+
+	children[1].endC <- `🛑`
+
+	assertSignal(t, children[1].stopC)
+	assertSignal(t, children[1].startC)
+
+	s.Stop()
+}
+
+// Test asserts that restarts outside of time window are not counted.
+func Test_Supervise_RestartWindow(t *testing.T) {
+	t.Parallel()
+
+	const window = time.Millisecond * 50
+
+	children := newSupervisedChildren(1)
+	s := Supervise(supervisedActors(children)...).
+		WithOptions(OptMaxRestarts(1, window)).
+		Build()
+
+	s.Start()
+	defer s.Stop()
+
+	assertChildrenStarted(t, children)
+
+	for range 3 {
+		children[0].endC <- `🛑`
+
+		assertSignal(t, children[0].stopC)
+		assertSignal(t, children[0].startC)
+
+		time.Sleep(window) //nolint:forbidigo // waiting for window to pass
+	}
+}
+
+type supervisedChild struct {
+	actor  Actor
+	startC chan any
+	stopC  chan any
+	endC   chan any
+}
+
+func newSupervisedChildren(count int) []*supervisedChild {
+	children := make([]*supervisedChild, count)
+
+	for i := range count {
+		c := &supervisedChild{
+			startC: make(chan any, 10),
+			stopC:  make(chan any, 10),
+			endC:   make(chan any),
+		}
+
+		c.actor = New(
+			NewWorker(func(ctx Context) WorkerStatus {
+				select {
+				case <-ctx.Done():
+					return WorkerEnd
+				case <-c.endC:
+					return WorkerEnd
+				}
+			}),
+			OptOnStart(func(Context) { c.startC <- `🌞` }),
+			OptOnStop(func() { c.stopC <- `🌚` }),
+		)
+
+		children[i] = c
+	}
+
+	return children
+}
+
+func supervisedActors(children []*supervisedChild) []Actor {
+	actors := make([]Actor, len(children))
+	for i, c := range children {
+		actors[i] = c.actor
+	}
+
+	return actors
+}
+
+func assertChildrenStarted(t *testing.T, children []*supervisedChild) {
+	t.Helper()
+
+	for _, c := range children {
+		assertSignal(t, c.startC)
+	}
+}
+
+func assertSignal(t *testing.T, c <-chan any) {
+	t.Helper()
+
+	select {
+	case <-c:
+	case <-time.After(time.Second):
+		assert.FailNow(t, "expected signal")
+	}
+}
+
+func assertNoSignal(t *testing.T, c <-chan any) {
+	t.Helper()
+
+	select {
+	case <-c:
+		assert.FailNow(t, "unexpected signal")
+	case <-time.After(time.Millisecond * 20):
+	}
+}
@@ -0,0 +1,163 @@
+package actor
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// NewTickerWorker returns a Worker which calls fn periodically, once
+// every interval. The returned WorkerStatus of fn is returned by DoWork.
+//
+// The ticker of the returned Worker is started when the Actor starts and
+// it is stopped when the Actor stops, therefore it is never leaked.
+//
+// By default, ticks happen at a fixed rate: every interval since the Actor
+// has started. If fn takes longer than interval, missed ticks are skipped.
+// OptFixedDelay makes ticks happen interval after fn has returned, while
+// OptJitter delays each tick by random duration. OptClock sets the Clock
+// used to measure time.
+//
+// Interval which is not positive is replaced with 1 millisecond, so that
+// fn is not called in a busy loop.
+func NewTickerWorker(
+	interval time.Duration,
+	fn func(ctx Context) WorkerStatus,
+	opt ...TimerOption,
+) Worker {
+	return &tickerWorker[any]{
+		ticker: newTicker(interval, newOptions(opt).Timer),
+		onTick: fn,
+	}
+}
+
+// NewTickerMailboxWorker returns a Worker which calls onTick periodically,
+// the same way as the Worker returned by NewTickerWorker, and which calls
+// onMessage for every message received from mbx, in between the ticks.
+// The returned WorkerStatus of these functions is returned by DoWork.
+//
+// The returned Worker ends when the receive channel of mbx is closed.
+func NewTickerMailboxWorker[T any](
+	interval time.Duration,
+	mbx MailboxReceiver[T],
+	onTick func(ctx Context) WorkerStatus,
+	onMessage func(ctx Context, msg T) WorkerStatus,
+	opt ...TimerOption,
+) Worker {
+	return &tickerWorker[T]{
+		ticker:    newTicker(interval, newOptions(opt).Timer),
+		onTick:    onTick,
+		receiveC:  mbx.ReceiveC(),
+		onMessage: onMessage,
+	}
+}
+
+type tickerWorker[T any] struct {
+	*ticker
+	onTick    func(Context) WorkerStatus
+	receiveC  <-chan T
+	onMessage func(Context, T) WorkerStatus
+}
+
+func (w *tickerWorker[T]) DoWork(ctx Context) WorkerStatus {
+	select {
+	case <-ctx.Done():
+		return WorkerEnd
+
+	case <-w.C():
+		status := w.onTick(ctx)
+		w.ticked()
+
+		return status
+
+	case msg, ok := <-w.receiveC:
+		if !ok {
+			return WorkerEnd
+		}
+
+		return w.onMessage(ctx, msg)
+	}
+}
+
+func (w *tickerWorker[T]) OnStart(Context) {
+	w.start()
+}
+
+func (w *tickerWorker[T]) OnStop() {
+	w.stop()
+}
+
+// minTickerInterval replaces interval of ticker which is not positive.
+const minTickerInterval = time.Millisecond
+
+// ticker delivers ticks using Timer of Clock, which is reset
+// after every tick is handled.
+type ticker struct {
+	interval time.Duration
+	options  optionsTimer
+	timer    Timer
+	next     time.Time
+}
+
+func newTicker(interval time.Duration, options optionsTimer) *ticker {
+	if options.Clock == nil {
+		options.Clock = SystemClock()
+	}
+
+	if interval <= 0 {
+		interval = minTickerInterval
+	}
+
+	return &ticker{
+		interval: interval,
+		options:  options,
+	}
+}
+
+func (t *ticker) start() {
+	now := t.options.Clock.Now()
+	t.next = now.Add(t.interval)
+	t.timer = t.options.Clock.NewTimer(t.interval + t.jitter())
+}
+
+func (t *ticker) stop() {
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+}
+
+// C returns channel on which ticks are delivered,
+// or nil if ticker has not been started.
+func (t *ticker) C() <-chan time.Time {
+	if t.timer == nil {
+		return nil
+	}
+
+	return t.timer.C()
+}
+
+// ticked schedules next tick, after the last tick has been handled.
+func (t *ticker) ticked() {
+	now := t.options.Clock.Now()
+
+	if t.options.FixedDelay {
+		t.next = now.Add(t.interval)
+	} else {
+		t.next = t.next.Add(t.interval)
+
+		// skip ticks which were missed while last tick was handled
+		if missed := now.Sub(t.next); missed >= 0 {
+			t.next = t.next.Add((missed/t.interval + 1) * t.interval)
+		}
+	}
+
+	t.timer.Reset(t.next.Sub(now) + t.jitter())
+}
+
+func (t *ticker) jitter() time.Duration {
+	if t.options.Jitter <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int64N(int64(t.options.Jitter))) //nolint:gosec // relax
+}
@@ -0,0 +1,176 @@
+package actor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that ticker worker calls function on every tick,
+// while ticker is stopped together with actor.
+func Test_TickerWorker(t *testing.T) {
+	t.Parallel()
+
+	tickC := make(chan any, 100)
+	a := New(NewTickerWorker(time.Millisecond, func(Context) WorkerStatus {
+		tickC <- `🕐`
+		return WorkerContinue
+	}))
+
+	a.Start()
+	drainC(tickC, 3)
+	a.Stop()
+
+	// Ticks are not delivered once actor has stopped
+	for range len(tickC) {
+		<-tickC
+	}
+
+	time.Sleep(time.Millisecond * 10) //nolint:forbidigo // waiting for potential tick
+	assert.Empty(t, tickC)
+
+	// Ticker is started again with actor
+	a.Start()
+	drainC(tickC, 3)
+	a.Stop()
+}
+
+// Test asserts that ticker worker ends when tick function returns WorkerEnd.
+func Test_TickerWorker_End(t *testing.T) {
+	t.Parallel()
+
+	a := New(NewTickerWorker(time.Millisecond, func(Context) WorkerStatus {
+		return WorkerEnd
+	}))
+
+	a.Start()
+	assertDone(t, a.(Waiter).Done()) //nolint:forcetypeassert // relax
+
+	AssertWorkerEndSig(t, NewTickerWorker(time.Hour, func(Context) WorkerStatus {
+		return WorkerContinue
+	}))
+}
+
+// Test asserts that missed ticks are skipped with fixed rate ticker,
+// and that interval is measured from handled tick with fixed delay ticker.
+func Test_TickerWorker_FixedRateAndDelay(t *testing.T) {
+	t.Parallel()
+
+	const interval = time.Second * 10
+
+	for _, tc := range []struct {
+		opt      TimerOption
+		expected time.Duration
+	}{
+		// next tick is due at 30s, since tick at 20s was missed
+		{OptJitter(0), time.Second * 5},
+		// next tick is due 10s after handling of the last tick has ended
+		{OptFixedDelay(), interval},
+	} {
+		clock := newFakeClock()
+		w := NewTickerWorker(interval, func(Context) WorkerStatus {
+			// tick is handled for 15s
+			clock.Advance(time.Second * 15)
+			return WorkerContinue
+		}, OptClock(clock), tc.opt)
+
+		w.(StartableWorker).OnStart(ContextStarted()) //nolint:forcetypeassert // relax
+		assert.Equal(t, interval, clock.LastDuration())
+
+		clock.Advance(interval)
+		assert.Equal(t, WorkerContinue, w.DoWork(ContextStarted()))
+		assert.Equal(t, tc.expected, clock.LastDuration())
+
+		w.(StoppableWorker).OnStop() //nolint:forcetypeassert // relax
+	}
+}
+
+// Test asserts that ticks are delayed by jitter.
+func Test_TickerWorker_Jitter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		interval = time.Second
+		jitter   = time.Millisecond * 100
+	)
+
+	clock := newFakeClock()
+	w := NewTickerWorker(interval, func(Context) WorkerStatus {
+		return WorkerContinue
+	}, OptClock(clock), OptJitter(jitter))
+
+	w.(StartableWorker).OnStart(ContextStarted()) //nolint:forcetypeassert // relax
+
+	for range 100 {
+		assert.GreaterOrEqual(t, clock.LastDuration(), interval)
+		assert.Less(t, clock.LastDuration(), interval+jitter)
+
+		clock.Advance(interval)
+		assert.Equal(t, WorkerContinue, w.DoWork(ContextStarted()))
+	}
+}
+
+// Test asserts that interval which is not positive is replaced
+// with 1 millisecond.
+func Test_TickerWorker_NonPositiveInterval(t *testing.T) {
+	t.Parallel()
+
+	onTick := func(Context) WorkerStatus { return WorkerContinue }
+	onMessage := func(Context, any) WorkerStatus { return WorkerContinue }
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		clock := newFakeClock()
+		mbx := NewMailbox[any]()
+
+		for _, w := range []Worker{
+			NewTickerWorker(interval, onTick, OptClock(clock)),
+			NewTickerMailboxWorker(interval, mbx, onTick, onMessage, OptClock(clock)),
+		} {
+			w.(StartableWorker).OnStart(ContextStarted()) //nolint:forcetypeassert // relax
+			assert.Equal(t, time.Millisecond, clock.LastDuration())
+
+			clock.Advance(time.Millisecond)
+			assert.Equal(t, WorkerContinue, w.DoWork(ContextStarted()))
+			assert.Equal(t, time.Millisecond, clock.LastDuration())
+
+			w.(StoppableWorker).OnStop() //nolint:forcetypeassert // relax
+		}
+	}
+}
+
+// Test asserts that ticker mailbox worker handles both ticks and messages,
+// and that it ends when mailbox is stopped.
+func Test_TickerMailboxWorker(t *testing.T) {
+	t.Parallel()
+
+	tickC := make(chan any, 100)
+	msgC := make(chan int, 100)
+
+	m := NewMailbox[int]()
+	w := NewTickerMailboxWorker(time.Millisecond, m,
+		func(Context) WorkerStatus {
+			tickC <- `🕐`
+			return WorkerContinue
+		},
+		func(_ Context, msg int) WorkerStatus {
+			msgC <- msg
+			return WorkerContinue
+		},
+	)
+	a := New(w)
+
+	m.Start()
+	a.Start()
+
+	assert.NoError(t, m.Send(ContextStarted(), 1))
+	assert.Equal(t, 1, <-msgC)
+	drainC(tickC, 3)
+
+	m.Stop()
+	assertDone(t, a.(Waiter).Done()) //nolint:forcetypeassert // relax
+
+	AssertWorkerEndSig(t, w)
+}
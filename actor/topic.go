@@ -0,0 +1,207 @@
+package actor
+
+import (
+	"errors"
+	"slices"
+	"sync"
+)
+
+// SlowConsumerPolicy defines how a Topic delivers messages to a subscriber
+// whose Mailbox is full, that is when the Mailbox has reached the length
+// limited with OptMaxLen.
+type SlowConsumerPolicy int8
+
+const (
+	// SlowConsumerBlock blocks the Topic until the subscriber accepts the message,
+	// which delays delivery of messages to all other subscribers.
+	SlowConsumerBlock SlowConsumerPolicy = 0
+
+	// SlowConsumerDrop discards the message for the subscriber.
+	SlowConsumerDrop SlowConsumerPolicy = 1
+
+	// SlowConsumerDisconnect unsubscribes the subscriber,
+	// which closes the receive channel of its Mailbox.
+	SlowConsumerDisconnect SlowConsumerPolicy = 2
+)
+
+// Topic is an Actor which delivers messages sent to it
+// to all of its subscribers.
+type Topic[T any] interface {
+	Actor
+	MailboxSender[T]
+
+	// Subscribe adds a new subscriber to the Topic, which receives messages
+	// from its own Mailbox, created with the supplied options. Messages are
+	// delivered to the subscriber according to policy.
+	Subscribe(policy SlowConsumerPolicy, opt ...MailboxOption) Subscription[T]
+}
+
+// Subscription is a subscriber of a Topic.
+type Subscription[T any] interface {
+	MailboxReceiver[T]
+
+	// Unsubscribe removes the subscriber from the Topic and stops its Mailbox.
+	Unsubscribe()
+}
+
+// NewTopic returns a new Topic, which receives messages from its own Mailbox,
+// created with the supplied options, and delivers them to all subscribers
+// in the order they were sent.
+//
+// Subscribers can subscribe and unsubscribe at any time, and they receive only
+// messages which are delivered while they are subscribed. Mailboxes of
+// subscribers are started together with the Topic. Subscribers whose Mailbox
+// is limited with OptMaxLen are handled according to their SlowConsumerPolicy
+// when the Mailbox is full, while unbounded Mailboxes always accept messages.
+// Mailboxes of subscribers which do not block the Topic use OverflowError policy.
+//
+// When the Topic is stopped, messages which it has already received are
+// delivered, after which all subscribers are unsubscribed and their Mailboxes
+// are stopped. Therefore, stopping the Topic waits for blocking subscribers
+// to accept these messages.
+//
+// Same as a Mailbox, a Topic can be started and stopped only once. Restarting
+// a stopped Topic has no effect, and subscribing to it returns a Subscription
+// whose receive channel is closed.
+//
+// Returned Topic implements Waiter and Stateful interfaces.
+func NewTopic[T any](opt ...MailboxOption) Topic[T] {
+	t := &topic[T]{}
+	t.receiver = newReceiver(NewMailbox[T](opt...), t.publish, []Option{
+		OptOnStart(t.startSubscribers),
+		OptOnStop(t.unsubscribeAll),
+	})
+
+	return t
+}
+
+type topic[T any] struct {
+	*receiver[T]
+	subscribers     []*subscription[T]
+	subscribersLock sync.Mutex
+	running         bool
+	stopped         bool
+}
+
+func (t *topic[T]) Start() {
+	t.subscribersLock.Lock()
+	stopped := t.stopped
+	t.subscribersLock.Unlock()
+
+	if !stopped {
+		t.receiver.Start()
+	}
+}
+
+func (t *topic[T]) Subscribe(
+	policy SlowConsumerPolicy,
+	opt ...MailboxOption,
+) Subscription[T] {
+	// full Mailbox rejects messages instead of blocking the Topic
+	if policy != SlowConsumerBlock {
+		opt = append(slices.Clone(opt), OptOverflow(OverflowError))
+	}
+
+	s := &subscription[T]{
+		topic:  t,
+		mbx:    NewMailbox[T](opt...),
+		policy: policy,
+	}
+
+	t.subscribersLock.Lock()
+	defer t.subscribersLock.Unlock()
+
+	switch {
+	case t.stopped:
+		// stopped Topic delivers no messages, therefore
+		// receive channel of the subscriber is closed
+		s.mbx.Start()
+		s.mbx.Stop()
+	case t.running:
+		t.subscribers = append(t.subscribers, s)
+		s.mbx.Start()
+	default:
+		t.subscribers = append(t.subscribers, s)
+	}
+
+	return s
+}
+
+func (t *topic[T]) startSubscribers(Context) {
+	t.subscribersLock.Lock()
+	defer t.subscribersLock.Unlock()
+
+	t.running = true
+
+	for _, s := range t.subscribers {
+		s.mbx.Start()
+	}
+}
+
+func (t *topic[T]) publish(ctx Context, msg T) WorkerStatus {
+	t.subscribersLock.Lock()
+	subscribers := slices.Clone(t.subscribers)
+	t.subscribersLock.Unlock()
+
+	// messages which were received before Topic has stopped are delivered
+	if ctx.Err() != nil {
+		ctx = ContextStarted()
+	}
+
+	for _, s := range subscribers {
+		err := s.mbx.Send(ctx, msg)
+		if errors.Is(err, ErrMailboxFull) && s.policy == SlowConsumerDisconnect {
+			s.Unsubscribe()
+		}
+	}
+
+	return WorkerContinue
+}
+
+// remove removes subscriber from the Topic,
+// and reports whether it was subscribed.
+func (t *topic[T]) remove(s *subscription[T]) bool {
+	t.subscribersLock.Lock()
+	defer t.subscribersLock.Unlock()
+
+	i := slices.Index(t.subscribers, s)
+	if i == -1 {
+		return false
+	}
+
+	t.subscribers = slices.Delete(t.subscribers, i, i+1)
+
+	return true
+}
+
+func (t *topic[T]) unsubscribeAll() {
+	t.subscribersLock.Lock()
+	subscribers := t.subscribers
+	t.subscribers = nil
+	t.running = false
+	t.stopped = true
+	t.subscribersLock.Unlock()
+
+	for _, s := range subscribers {
+		s.mbx.Stop()
+	}
+}
+
+type subscription[T any] struct {
+	topic  *topic[T]
+	mbx    Mailbox[T]
+	policy SlowConsumerPolicy
+}
+
+func (s *subscription[T]) ReceiveC() <-chan T {
+	return s.mbx.ReceiveC()
+}
+
+func (s *subscription[T]) Unsubscribe() {
+	if s.topic.remove(s) {
+		// Mailbox is started, unless it already is,
+		// so that stopping it closes its receive channel
+		s.mbx.Start()
+		s.mbx.Stop()
+	}
+}
@@ -0,0 +1,147 @@
+package actor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	. "github.com/vladopajic/go-actor/actor"
+)
+
+// Test asserts that topic delivers messages to all subscribers,
+// while they are subscribed.
+func Test_Topic(t *testing.T) {
+	t.Parallel()
+
+	topic := NewTopic[int]()
+	s1 := topic.Subscribe(SlowConsumerBlock)
+	s2 := topic.Subscribe(SlowConsumerBlock)
+
+	topic.Start()
+	defer topic.Stop()
+
+	for i := range 10 {
+		assert.NoError(t, topic.Send(ContextStarted(), i))
+	}
+
+	for i := range 10 {
+		assert.Equal(t, i, <-s1.ReceiveC())
+		assert.Equal(t, i, <-s2.ReceiveC())
+	}
+
+	s1.Unsubscribe()
+	s1.Unsubscribe() // should have no effect
+	assertReceiveClosed(t, s1.ReceiveC())
+
+	// Subscriber added later receives only messages sent after it has subscribed
+	s3 := topic.Subscribe(SlowConsumerBlock)
+
+	assert.NoError(t, topic.Send(ContextStarted(), 10))
+	assert.Equal(t, 10, <-s2.ReceiveC())
+	assert.Equal(t, 10, <-s3.ReceiveC())
+}
+
+// Test asserts that topic delivers messages to slow subscribers
+// according to their policy.
+func Test_Topic_SlowConsumer(t *testing.T) {
+	t.Parallel()
+
+	topic := NewTopic[int]()
+	blocking := topic.Subscribe(SlowConsumerBlock, OptMaxLen(1))
+	dropping := topic.Subscribe(SlowConsumerDrop, OptMaxLen(1))
+	disconnecting := topic.Subscribe(SlowConsumerDisconnect, OptMaxLen(1))
+
+	// Messages are delivered to subscribers in order they have subscribed,
+	// therefore probe receives message once others have received it as well
+	probe := topic.Subscribe(SlowConsumerBlock)
+
+	topic.Start()
+	defer topic.Stop()
+
+	for i := range 3 {
+		assert.NoError(t, topic.Send(ContextStarted(), i))
+	}
+
+	// Topic is blocked until blocking subscriber receives messages
+	assert.Equal(t, 0, <-probe.ReceiveC())
+	assert.Equal(t, 0, <-blocking.ReceiveC())
+	assert.Equal(t, 1, <-probe.ReceiveC())
+	assert.Equal(t, 1, <-blocking.ReceiveC())
+	assert.Equal(t, 2, <-probe.ReceiveC())
+	assert.Equal(t, 2, <-blocking.ReceiveC())
+
+	// Dropping subscriber has received only the first message
+	assert.Equal(t, 0, <-dropping.ReceiveC())
+	assertNoReceive(t, dropping.ReceiveC())
+
+	// Disconnecting subscriber was unsubscribed on second message
+	assertReceiveClosed(t, disconnecting.ReceiveC())
+}
+
+// Test asserts that stopping topic delivers received messages,
+// and stops mailboxes of all subscribers.
+func Test_Topic_Stop(t *testing.T) {
+	t.Parallel()
+
+	topic := NewTopic[int](OptStopAfterReceivingAll())
+	s1 := topic.Subscribe(SlowConsumerBlock, OptStopAfterReceivingAll())
+	s2 := topic.Subscribe(SlowConsumerDrop)
+
+	topic.Start()
+
+	for i := range 10 {
+		assert.NoError(t, topic.Send(ContextStarted(), i))
+	}
+
+	topic.Stop()
+	assertDone(t, topic.(Waiter).Done()) //nolint:forcetypeassert // relax
+
+	for i := range 10 {
+		assert.Equal(t, i, <-s1.ReceiveC())
+	}
+
+	assertReceiveClosed(t, s1.ReceiveC())
+	assertReceiveClosed(t, s2.ReceiveC())
+	assert.ErrorIs(t, topic.Send(ContextStarted(), 1), ErrMailboxStopped)
+
+	s1.Unsubscribe() // should have no effect
+
+	// Stopped topic can not be started again
+	topic.Start()
+	assert.Equal(t, StateStopped, topic.(Stateful).State()) //nolint:forcetypeassert // relax
+
+	// Subscribing to stopped topic returns closed subscription
+	s3 := topic.Subscribe(SlowConsumerBlock)
+	assertReceiveClosed(t, s3.ReceiveC())
+	s3.Unsubscribe() // should have no effect
+}
+
+func Test_Topic_Invariants(t *testing.T) {
+	t.Parallel()
+
+	TestSuite(t, func() Actor {
+		topic := NewTopic[any]()
+		topic.Subscribe(SlowConsumerDrop, OptMaxLen(1))
+
+		return topic
+	})
+}
+
+// assertReceiveClosed asserts that receive channel is closed,
+// after messages that it still holds are received.
+func assertReceiveClosed[T any](t *testing.T, c <-chan T) {
+	t.Helper()
+
+	for range c { //nolint:revive // messages are discarded
+	}
+}
+
+func assertNoReceive[T any](t *testing.T, c <-chan T) {
+	t.Helper()
+
+	select {
+	case msg := <-c:
+		assert.Fail(t, "unexpected message", msg)
+	default:
+	}
+}